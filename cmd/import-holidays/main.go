@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"github.com/pedroxer/booking-service/internal/config"
+	"github.com/pedroxer/booking-service/internal/services/booking"
+	"github.com/pedroxer/booking-service/internal/storage"
+	"github.com/pedroxer/booking-service/internal/utills"
+	log "github.com/sirupsen/logrus"
+	"os"
+)
+
+// Imports public holidays from an .ics file, for one zone or for all zones.
+func main() {
+	configPath := flag.String("config", "./config/config.json", "path to config file")
+	icsPath := flag.String("ics", "", "path to .ics file with holidays")
+	zone := flag.String("zone", "", "zone the holidays apply to, all zones when empty")
+	flag.Parse()
+
+	log := log.New()
+	if *icsPath == "" {
+		log.Fatal("-ics is required")
+	}
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	store, err := storage.NewStorage(&cfg.Postgres, &cfg.Clickhouse, log)
+	if err != nil {
+		log.Fatalf("failed connect to db %s", err)
+	}
+	resourceClient, err := utills.CreateResourceClient(cfg.ResourceService)
+	if err != nil {
+		log.Fatal("failed to create resource client ", err)
+	}
+	bookingService := booking.NewBookingService(log, cfg.Booking, store, resourceClient, store, store, store, store, store, store, store, store)
+
+	file, err := os.Open(*icsPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+	added, err := bookingService.ImportHolidays(context.Background(), *zone, file)
+	if err != nil {
+		log.Fatalf("failed to import holidays: %s", err)
+	}
+	log.Infof("%d holidays imported", added)
+}
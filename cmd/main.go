@@ -1,31 +1,21 @@
 package main
 
 import (
-	"encoding/json"
-	"github.com/caarlos0/env/v6"
+	"context"
 	"github.com/pedroxer/booking-service/internal/app"
 	"github.com/pedroxer/booking-service/internal/config"
 	"github.com/pedroxer/booking-service/internal/prometheus"
 	"github.com/pedroxer/booking-service/internal/storage"
 	"github.com/pedroxer/booking-service/internal/utills"
 	log "github.com/sirupsen/logrus"
-	"os"
 )
 
 func main() {
 	log := setupLogger()
-	data, err := os.ReadFile("./config/config.json")
+	cfg, err := config.Load("./config/config.json")
 	if err != nil {
 		log.Fatal(err)
 	}
-	cfg := new(config.Config)
-
-	if err := json.Unmarshal(data, cfg); err != nil {
-		log.Fatal(err)
-	}
-	if err := env.Parse(cfg); err != nil {
-		log.Fatal(err)
-	}
 
 	go func() {
 		err = prometheus.RunRestServer()
@@ -48,7 +38,8 @@ func main() {
 		log.Fatal("failed to create resource client ", err)
 	}
 	log.Info("connected to resource service")
-	app := app.NewApp(log, cfg.Port, store, resourceClient)
+	app := app.NewApp(log, cfg.Port, cfg.Booking, store, resourceClient)
+	app.RunJobs(context.Background())
 	if err := app.GRPCSrv.Run(); err != nil {
 		log.Fatal(err)
 	}
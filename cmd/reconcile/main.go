@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"github.com/pedroxer/booking-service/internal/config"
+	"github.com/pedroxer/booking-service/internal/services/booking"
+	"github.com/pedroxer/booking-service/internal/storage"
+	"github.com/pedroxer/booking-service/internal/utills"
+	log "github.com/sirupsen/logrus"
+)
+
+// One-off repair of resources left unavailable by the old IsAvailable booking lock.
+func main() {
+	configPath := flag.String("config", "./config/config.json", "path to config file")
+	dryRun := flag.Bool("dry-run", false, "only print resources that would be repaired")
+	flag.Parse()
+
+	log := log.New()
+	cfg, err := config.Load(*configPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	store, err := storage.NewStorage(&cfg.Postgres, &cfg.Clickhouse, log)
+	if err != nil {
+		log.Fatalf("failed connect to db %s", err)
+	}
+	resourceClient, err := utills.CreateResourceClient(cfg.ResourceService)
+	if err != nil {
+		log.Fatal("failed to create resource client ", err)
+	}
+	bookingService := booking.NewBookingService(log, cfg.Booking, store, resourceClient, store, store, store, store, store, store, store, store)
+
+	for _, bookingType := range []string{utills.WorkplaceType, utills.ParkingType} {
+		repaired, err := bookingService.ReconcileAvailability(context.Background(), bookingType, *dryRun)
+		if err != nil {
+			log.Fatalf("failed to reconcile %s: %s", bookingType, err)
+		}
+		log.Infof("%s: %d resources repaired %v", bookingType, len(repaired), repaired)
+	}
+}
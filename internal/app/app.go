@@ -1,19 +1,24 @@
 package app
 
 import (
+	"context"
 	grpc_app "github.com/pedroxer/booking-service/internal/app/grpc"
+	"github.com/pedroxer/booking-service/internal/config"
 	proto_gen "github.com/pedroxer/booking-service/internal/proto_gen/protos"
 	"github.com/pedroxer/booking-service/internal/services/booking"
 	"github.com/pedroxer/booking-service/internal/storage"
 	log "github.com/sirupsen/logrus"
+	"time"
 )
 
 type App struct {
-	GRPCSrv *grpc_app.App
+	GRPCSrv        *grpc_app.App
+	bookingService *booking.BookingService
+	noShowInterval time.Duration
 }
 
-func NewApp(log *log.Logger, grpcPort int, store *storage.Storage, resourceClient proto_gen.ResourceServiceClient) *App {
-	bookingService := booking.NewBookingService(log, store, resourceClient, store, store, store)
+func NewApp(log *log.Logger, grpcPort int, bookingCfg config.Booking, store *storage.Storage, resourceClient proto_gen.ResourceServiceClient) *App {
+	bookingService := booking.NewBookingService(log, bookingCfg, store, resourceClient, store, store, store, store, store, store, store, store)
 	grpcApp := grpc_app.NewApp(
 		log,
 		grpcPort,
@@ -21,6 +26,13 @@ func NewApp(log *log.Logger, grpcPort int, store *storage.Storage, resourceClien
 	)
 
 	return &App{
-		GRPCSrv: grpcApp,
+		GRPCSrv:        grpcApp,
+		bookingService: bookingService,
+		noShowInterval: time.Duration(bookingCfg.NoShowCheckIntervalSeconds) * time.Second,
 	}
 }
+
+// RunJobs starts background jobs of the service, they stop when ctx is done.
+func (a *App) RunJobs(ctx context.Context) {
+	go a.bookingService.RunNoShowReleaser(ctx, a.noShowInterval)
+}
@@ -5,6 +5,7 @@ type Config struct {
 	Port            int             `json:"port"`
 	ResourceService ResourceService `json:"resource_service"`
 	Clickhouse      Clickhouse      `json:"clickhouse"`
+	Booking         Booking         `json:"booking"`
 }
 
 type Postgres struct {
@@ -26,3 +27,36 @@ type Clickhouse struct {
 	ClickUser string `env:"CLICK_USER,notEmpty"`
 	ClickPass string `env:"CLICK_PASS,notEmpty"`
 }
+
+type Booking struct {
+	CheckInBeforeMinutes       int `json:"check_in_before_minutes"`
+	CheckInAfterMinutes        int `json:"check_in_after_minutes"`
+	NoShowCheckIntervalSeconds int `json:"no_show_check_interval_seconds"`
+	OpeningHour                int `json:"opening_hour"`
+	ClosingHour                int `json:"closing_hour"` // 0 - офис открыт до конца дня
+	MinSlotMinutes             int `json:"min_slot_minutes"`
+	// RFC 3339, брони, начавшиеся раньше, не освобождаются как неявка; пусто - с запуска сервиса
+	NoShowSince string `json:"no_show_since"`
+	// IANA зона офисов, для которых нет строки в booking_service.zone_time_zones
+	TimeZone string `json:"time_zone"`
+	// Буферы по типу брони, строка в booking_service.resource_buffers перекрывает их для ресурса
+	Buffers map[string]Buffer `json:"buffers"`
+	// Политики по типу брони, строка в booking_service.policies для типа их перекрывает
+	Policies map[string]Policy `json:"policies"`
+}
+
+// Buffer - сколько ресурс остаётся занятым до и после брони (подготовка, уборка)
+type Buffer struct {
+	BeforeMinutes int `json:"before_minutes"`
+	AfterMinutes  int `json:"after_minutes"`
+}
+
+// Policy - правила бронирования, 0 - ограничения нет
+type Policy struct {
+	MaxDurationMinutes int                 `json:"max_duration_minutes"`
+	MaxAdvanceDays     int                 `json:"max_advance_days"`
+	MinLeadMinutes     int                 `json:"min_lead_minutes"`
+	MaxActiveBookings  int                 `json:"max_active_bookings"`
+	MaxDaysPerWeek     int                 `json:"max_days_per_week"`
+	ZoneGroups         map[string][]string `json:"zone_groups"` // зона -> группы, которым её можно бронировать
+}
@@ -5,18 +5,18 @@ import (
 	"fmt"
 	"github.com/ClickHouse/clickhouse-go/v2"
 	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/pedroxer/booking-service/internal/config"
 )
 
-func ConnectToPg(cfg *config.Postgres) (*pgx.Conn, error) {
+func ConnectToPg(cfg *config.Postgres) (*pgxpool.Pool, error) {
 	dsn := fmt.Sprintf(`postgres://%s:%s@%s:%d/%s`,
 		cfg.User,
 		cfg.Password,
 		cfg.Host,
 		cfg.Port,
 		cfg.Db)
-	conn, err := pgx.Connect(context.Background(), dsn)
+	conn, err := pgxpool.New(context.Background(), dsn)
 	if err != nil {
 		return nil, err
 	}
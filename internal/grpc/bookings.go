@@ -11,17 +11,43 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"strings"
 	"time"
 )
 
 type BookingInterface interface {
-	GetBookings(ctx context.Context, bookingType string, startTime, endTime time.Time, userId string, resourceID, page int64) ([]models.Booking, int64, error)
+	GetBookings(ctx context.Context, bookingType string, startTime, endTime time.Time, userId, bookedBy string, resourceID, page int64) ([]models.Booking, int64, error)
 	GetBookingById(ctx context.Context, bookingType string, bookingId int64) (models.Booking, error)
-	CreateBooking(ctx context.Context, bookingType, status string, startTime, endTime time.Time, userId string, resourceId int64) (models.Booking, error)
-	UpdateBooking(ctx context.Context, bookingType, status string, bookingID int64, startTime, endTime time.Time) (models.Booking, error)
-	CancelBooking(ctx context.Context, bookingType string, bookingId int64) (bool, error)
-	ApproveBooking(ctx context.Context, uniqueTag string) (bool, error) // Только для workplace
-	GetTimeSlotsForBooking(ctx context.Context, bookingType string, resourceId int64, date time.Time) ([]models.TimeSlot, error)
+	CreateBooking(ctx context.Context, bookingType string, booking models.Booking) (models.Booking, error)
+	UpdateBooking(ctx context.Context, bookingType, status string, bookingID int64, startTime, endTime time.Time, scope, userId string) (models.Booking, error)
+	ExtendBooking(ctx context.Context, bookingType string, bookingId int64, userId string, by time.Duration) (models.Booking, error)
+	CancelBooking(ctx context.Context, bookingType string, bookingId int64, scope, userId, reason string) (bool, error)
+	ApproveBooking(ctx context.Context, bookingType, uniqueTag, userId, licencePlate string) (models.Booking, error)
+	RespondToInvitation(ctx context.Context, bookingId int64, userId string, accept bool) (models.Booking, error)
+	MoveBooking(ctx context.Context, bookingType string, bookingId int64, userId string, resourceId int64) (models.Booking, error)
+	TransferBooking(ctx context.Context, bookingType string, bookingId int64, fromUserId, toUserId string, requireAcceptance bool) (models.Booking, models.BookingTransfer, error)
+	RespondToTransfer(ctx context.Context, transferId int64, userId string, accept bool) (models.Booking, error)
+	CheckOutBooking(ctx context.Context, bookingType string, bookingId int64, uniqueTag, userId string) (models.Booking, error)
+	GetTimeSlotsForBooking(ctx context.Context, bookingType string, resourceId int64, date time.Time) ([]models.TimeSlot, string, error)
+	GetResourcePools(ctx context.Context, zone string) ([]models.ResourcePool, error)
+	CreateBlackout(ctx context.Context, blackout models.Blackout) (models.Blackout, error)
+	DeleteBlackout(ctx context.Context, blackoutId int64) (bool, error)
+	GetOpeningHours(ctx context.Context, zone string) ([]models.OpeningHours, error)
+	SetOpeningHours(ctx context.Context, zone string, hours []models.OpeningHours) ([]models.OpeningHours, error)
+	GetAvailabilityMatrix(ctx context.Context, bookingType string, resourceIds []int64, filter models.ResourceFilter, startTime, endTime time.Time, granularity time.Duration) ([]models.ResourceOccupancy, error)
+	SearchAvailableResources(ctx context.Context, bookingType string, startTime, endTime time.Time, filter models.ResourceFilter, page int64) ([]models.Resource, int64, error)
+	CreateRecurringBooking(ctx context.Context, bookingType, status string, startTime, endTime time.Time, userId, bookedBy string, resourceId int64, rule string, exDates []time.Time) (models.BookingSeries, error)
+	CreateBookingBundle(ctx context.Context, userId, bookedBy string, items []models.BundleBooking) (int64, []models.BundleBooking, error)
+	JoinWaitlist(ctx context.Context, entry models.WaitlistEntry) (models.WaitlistEntry, error)
+	LeaveWaitlist(ctx context.Context, entryId int64, userId string) (bool, error)
+	ListWaitlist(ctx context.Context, bookingType, userId string, resourceId int64, page int64) ([]models.WaitlistEntry, int64, error)
+	CreateDelegation(ctx context.Context, userId string, delegation models.Delegation) (models.Delegation, error)
+	DeleteDelegation(ctx context.Context, delegationId int64, principalId string) (bool, error)
+	ListDelegations(ctx context.Context, userId string) ([]models.Delegation, error)
+	CreateGuestBooking(ctx context.Context, bookingType string, booking models.Booking, guest models.Guest) (models.GuestBooking, error)
+	ApproveGuestBooking(ctx context.Context, code string) (models.Booking, error)
+	ListVisitors(ctx context.Context, date time.Time, zone string) ([]models.GuestBooking, error)
+	CreateTeamBooking(ctx context.Context, bookedBy string, userIds []string, startTime, endTime time.Time, filter models.ResourceFilter) (models.TeamBooking, error)
 }
 
 type bookingAPI struct {
@@ -39,7 +65,7 @@ func (b *bookingAPI) CreateBooking(ctx context.Context, req *proto_gen.CreateBoo
 		return nil, status.Error(codes.InvalidArgument, "user id is required")
 	}
 	if req.BookingType == "" {
-		return nil, status.Error(codes.InvalidArgument, "resource type is required. Available resource types: workplace, parking")
+		return nil, status.Error(codes.InvalidArgument, "resource type is required. Available resource types: workplace, parking, room, pool")
 	}
 	if req.ResourceId == 0 {
 		return nil, status.Error(codes.InvalidArgument, "resource id is required")
@@ -50,8 +76,17 @@ func (b *bookingAPI) CreateBooking(ctx context.Context, req *proto_gen.CreateBoo
 	if req.EndTime == nil {
 		return nil, status.Error(codes.InvalidArgument, "end time is required")
 	}
-	b.logger.Infof("Creating booking with user id: %d and resource type: %s, id: %d ", req.UserId, req.BookingType, req.ResourceId)
-	resp, err := b.bookingService.CreateBooking(ctx, req.BookingType, utills.StatusPending, protoTimestampToTime(req.StartTime), protoTimestampToTime(req.EndTime), req.UserId, req.ResourceId)
+	b.logger.Infof("Creating booking with user id: %s and resource type: %s, id: %d ", req.UserId, req.BookingType, req.ResourceId)
+	resp, err := b.bookingService.CreateBooking(ctx, req.BookingType, models.Booking{
+		UserId:       req.UserId,
+		ResourceId:   req.ResourceId,
+		StartTime:    protoTimestampToTime(req.StartTime),
+		EndTime:      protoTimestampToTime(req.EndTime),
+		Status:       utills.StatusPending,
+		LicencePlate: req.LicencePlate,
+		Attendees:    attendeesFromIds(req.AttendeeIds),
+		BookedBy:     req.BookedBy,
+	})
 	if err != nil {
 		b.logger.Errorf("Error creating booking: %v", err)
 		return nil, generateErrors(err)
@@ -65,7 +100,7 @@ func (b *bookingAPI) GetBookingById(ctx context.Context, req *proto_gen.GetBooki
 		return nil, status.Error(codes.InvalidArgument, "booking id is required")
 	}
 	if req.BookingType == "" {
-		return nil, status.Error(codes.InvalidArgument, "resource type is required. Available resource types: workplace, parking")
+		return nil, status.Error(codes.InvalidArgument, "resource type is required. Available resource types: workplace, parking, room, pool")
 	}
 	resp, err := b.bookingService.GetBookingById(ctx, req.BookingType, req.Id)
 	if err != nil {
@@ -81,12 +116,12 @@ func (b *bookingAPI) GetBookings(ctx context.Context, req *proto_gen.GetBookings
 	}
 
 	if req.BookingType == "" {
-		return nil, status.Error(codes.InvalidArgument, "resource type is required. Available resource types: workplace, parking")
+		return nil, status.Error(codes.InvalidArgument, "resource type is required. Available resource types: workplace, parking, room, pool")
 	}
 	//if req.UserId == 0 {
 	//	return nil, status.Error(codes.InvalidArgument, "user id is required")
 	//}
-	resp, count, err := b.bookingService.GetBookings(ctx, req.BookingType, protoTimestampToTime(req.StartTime), protoTimestampToTime(req.EndTime), req.UserId, req.ResourceId, req.Page)
+	resp, count, err := b.bookingService.GetBookings(ctx, req.BookingType, protoTimestampToTime(req.StartTime), protoTimestampToTime(req.EndTime), req.UserId, req.BookedBy, req.ResourceId, req.Page)
 	if err != nil {
 		b.logger.Errorf("Error getting bookings: %v", err)
 		return nil, generateErrors(err)
@@ -107,10 +142,13 @@ func (b *bookingAPI) UpdateBooking(ctx context.Context, req *proto_gen.UpdateBoo
 		return nil, status.Error(codes.InvalidArgument, "booking id is required")
 	}
 	if req.BookingType == "" {
-		return nil, status.Error(codes.InvalidArgument, "resource type is required. Available resource types: workplace, parking")
+		return nil, status.Error(codes.InvalidArgument, "resource type is required. Available resource types: workplace, parking, room, pool")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
 	}
 	b.logger.Infof("updating booking %s with id: %d", req.BookingType, req.Id)
-	resp, err := b.bookingService.UpdateBooking(ctx, req.BookingType, req.Status, req.Id, protoTimestampToTime(req.StartTime), protoTimestampToTime(req.EndTime))
+	resp, err := b.bookingService.UpdateBooking(ctx, req.BookingType, statusFromGrpc(req.Status), req.Id, protoTimestampToTime(req.StartTime), protoTimestampToTime(req.EndTime), seriesScope(req.Scope), req.UserId)
 	if err != nil {
 		b.logger.Errorf("Error updating booking: %v", err)
 		return nil, generateErrors(err)
@@ -118,15 +156,40 @@ func (b *bookingAPI) UpdateBooking(ctx context.Context, req *proto_gen.UpdateBoo
 	return bookingToGrpcBooking(&resp), nil
 }
 
+func (b *bookingAPI) ExtendBooking(ctx context.Context, req *proto_gen.ExtendBookingRequest) (*proto_gen.Booking, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "booking id is required")
+	}
+	if req.BookingType == "" {
+		return nil, status.Error(codes.InvalidArgument, "resource type is required. Available resource types: workplace, parking, room, pool")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+	if req.Minutes <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "minutes must be positive")
+	}
+	b.logger.Infof("extending booking %s with id %d by %d minutes", req.BookingType, req.Id, req.Minutes)
+	resp, err := b.bookingService.ExtendBooking(ctx, req.BookingType, req.Id, req.UserId, time.Duration(req.Minutes)*time.Minute)
+	if err != nil {
+		b.logger.Errorf("Error extending booking: %v", err)
+		return nil, generateErrors(err)
+	}
+	return bookingToGrpcBooking(&resp), nil
+}
+
 func (b *bookingAPI) CancelBooking(ctx context.Context, req *proto_gen.CancelBookingRequest) (*proto_gen.CancelBookingResponse, error) {
 	if req.Id == 0 {
 		return nil, status.Error(codes.InvalidArgument, "booking id is required")
 	}
 	if req.BookingType == "" {
-		return nil, status.Error(codes.InvalidArgument, "resource type is required. Available resource types: workplace, parking")
+		return nil, status.Error(codes.InvalidArgument, "resource type is required. Available resource types: workplace, parking, room, pool")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
 	}
 	b.logger.Infof("Canceling booking %s with id: %d", req.BookingType, req.Id)
-	resp, err := b.bookingService.CancelBooking(ctx, req.BookingType, req.Id)
+	resp, err := b.bookingService.CancelBooking(ctx, req.BookingType, req.Id, cancelScope(req), req.UserId, req.Reason)
 	if err != nil {
 		b.logger.Errorf("Error canceling booking: %v", err)
 		return &proto_gen.CancelBookingResponse{
@@ -139,20 +202,117 @@ func (b *bookingAPI) CancelBooking(ctx context.Context, req *proto_gen.CancelBoo
 }
 
 func (b *bookingAPI) ApproveByQRBooking(ctx context.Context, req *proto_gen.ApproveByQRBookingRequest) (*proto_gen.ApproveByQRBookingResponse, error) {
+	if req.GuestCode != "" {
+		booking, err := b.bookingService.ApproveGuestBooking(ctx, req.GuestCode)
+		if err != nil {
+			b.logger.Errorf("Error approving guest booking: %v", err)
+			return nil, generateErrors(err)
+		}
+		return &proto_gen.ApproveByQRBookingResponse{Booking: bookingToGrpcBooking(&booking)}, nil
+	}
 	if req.UniqueTag == "" {
 		return nil, status.Error(codes.InvalidArgument, "unique tag is required")
 	}
-	success, err := b.bookingService.ApproveBooking(ctx, req.UniqueTag)
+	if req.BookingType == "" {
+		req.BookingType = utills.WorkplaceType
+	}
+	if req.UserId == "" && (req.BookingType != utills.ParkingType || req.LicencePlate == "") {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+	booking, err := b.bookingService.ApproveBooking(ctx, req.BookingType, req.UniqueTag, req.UserId, req.LicencePlate)
 	if err != nil {
 		b.logger.Errorf("Error approving booking: %v", err)
-		return &proto_gen.ApproveByQRBookingResponse{Success: success}, generateErrors(err)
+		return nil, generateErrors(err)
+	}
+	return &proto_gen.ApproveByQRBookingResponse{Booking: bookingToGrpcBooking(&booking)}, nil
+}
+
+func (b *bookingAPI) RespondToInvitation(ctx context.Context, req *proto_gen.RespondToInvitationRequest) (*proto_gen.Booking, error) {
+	if req.BookingId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "booking id is required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+	b.logger.Infof("User %s responds to invitation to booking %d: %t", req.UserId, req.BookingId, req.Accept)
+	booking, err := b.bookingService.RespondToInvitation(ctx, req.BookingId, req.UserId, req.Accept)
+	if err != nil {
+		b.logger.Errorf("Error responding to invitation: %v", err)
+		return nil, generateErrors(err)
+	}
+	return bookingToGrpcBooking(&booking), nil
+}
+
+func (b *bookingAPI) CheckOutBooking(ctx context.Context, req *proto_gen.CheckOutBookingRequest) (*proto_gen.Booking, error) {
+	if req.Id == 0 && req.UniqueTag == "" {
+		return nil, status.Error(codes.InvalidArgument, "booking id or unique tag is required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+	if req.BookingType == "" {
+		return nil, status.Error(codes.InvalidArgument, "resource type is required. Available resource types: workplace, parking, room")
+	}
+	b.logger.Infof("Checking out booking %s with id: %d, unique tag: %s", req.BookingType, req.Id, req.UniqueTag)
+	booking, err := b.bookingService.CheckOutBooking(ctx, req.BookingType, req.Id, req.UniqueTag, req.UserId)
+	if err != nil {
+		b.logger.Errorf("Error checking out booking: %v", err)
+		return nil, generateErrors(err)
+	}
+	return bookingToGrpcBooking(&booking), nil
+}
+
+func (b *bookingAPI) CreateBookingBundle(ctx context.Context, req *proto_gen.CreateBookingBundleRequest) (*proto_gen.BookingBundle, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+	if len(req.Items) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "bundle items are required")
+	}
+	items := make([]models.BundleBooking, 0, len(req.Items))
+	for _, item := range req.Items {
+		if item.BookingType == "" {
+			return nil, status.Error(codes.InvalidArgument, "resource type is required. Available resource types: workplace, parking, room, pool")
+		}
+		if item.ResourceId == 0 {
+			return nil, status.Error(codes.InvalidArgument, "resource id is required")
+		}
+		if item.StartTime == nil {
+			return nil, status.Error(codes.InvalidArgument, "start time is required")
+		}
+		if item.EndTime == nil {
+			return nil, status.Error(codes.InvalidArgument, "end time is required")
+		}
+		items = append(items, models.BundleBooking{
+			BookingType: item.BookingType,
+			Booking: models.Booking{
+				ResourceId:   item.ResourceId,
+				StartTime:    protoTimestampToTime(item.StartTime),
+				EndTime:      protoTimestampToTime(item.EndTime),
+				LicencePlate: item.LicencePlate,
+			},
+		})
 	}
-	return &proto_gen.ApproveByQRBookingResponse{Success: success}, nil
+	b.logger.Infof("Creating booking bundle of %d items for user id: %s", len(items), req.UserId)
+	bundleId, bookings, err := b.bookingService.CreateBookingBundle(ctx, req.UserId, req.BookedBy, items)
+	if err != nil {
+		b.logger.Errorf("Error creating booking bundle: %v", err)
+		return nil, generateErrors(err)
+	}
+	grpcResp := &proto_gen.BookingBundle{BundleId: bundleId}
+	for _, item := range bookings {
+		prometheus.IncrementBookingCounter(item.BookingType)
+		grpcResp.Bookings = append(grpcResp.Bookings, &proto_gen.BundleBooking{
+			BookingType: item.BookingType,
+			Booking:     bookingToGrpcBooking(&item.Booking),
+		})
+	}
+	return grpcResp, nil
 }
 
 func (b *bookingAPI) GetSlotsToBooking(ctx context.Context, req *proto_gen.GetSlotsToBookingRequest) (*proto_gen.GetSlotsToBookingResponse, error) {
 	if req.BookingType == "" {
-		return nil, status.Error(codes.InvalidArgument, "resource type is required. Available resource types: workplace, parking")
+		return nil, status.Error(codes.InvalidArgument, "resource type is required. Available resource types: workplace, parking, room, pool")
 	}
 	if req.ResourceId == 0 {
 		return nil, status.Error(codes.InvalidArgument, "resource id is required")
@@ -161,7 +321,7 @@ func (b *bookingAPI) GetSlotsToBooking(ctx context.Context, req *proto_gen.GetSl
 		return nil, status.Error(codes.InvalidArgument, "date is required")
 	}
 	b.logger.Info("getting free slot for booking: ", req.BookingType, " with resourceId: ", req.ResourceId)
-	timeSlots, err := b.bookingService.GetTimeSlotsForBooking(ctx, req.BookingType, req.ResourceId, protoTimestampToTime(req.Date))
+	timeSlots, timeZone, err := b.bookingService.GetTimeSlotsForBooking(ctx, req.BookingType, req.ResourceId, protoTimestampToTime(req.Date))
 	if err != nil {
 		b.logger.Errorf("Error getting free slots for booking: %v", err)
 		return nil, generateErrors(err)
@@ -169,24 +329,252 @@ func (b *bookingAPI) GetSlotsToBooking(ctx context.Context, req *proto_gen.GetSl
 	grpcTimeSlots := make([]*proto_gen.TimeSlot, len(timeSlots))
 	for i, timeSlot := range timeSlots {
 		grpcTimeSlots[i] = &proto_gen.TimeSlot{
-			StartTime: timestamppb.New(timeSlot.StartTime),
-			EndTime:   timestamppb.New(timeSlot.EndTime),
+			StartTime:         timestamppb.New(timeSlot.StartTime),
+			EndTime:           timestamppb.New(timeSlot.EndTime),
+			Busy:              timeSlot.Busy,
+			Closed:            timeSlot.Closed,
+			RemainingCapacity: timeSlot.RemainingCapacity,
+		}
+	}
+	return &proto_gen.GetSlotsToBookingResponse{Slots: grpcTimeSlots, TimeZone: timeZone}, nil
+}
+
+func (b *bookingAPI) ListResourcePools(ctx context.Context, req *proto_gen.ListResourcePoolsRequest) (*proto_gen.ListResourcePoolsResponse, error) {
+	pools, err := b.bookingService.GetResourcePools(ctx, req.Zone)
+	if err != nil {
+		b.logger.Errorf("Error getting resource pools: %v", err)
+		return nil, generateErrors(err)
+	}
+	grpcPools := make([]*proto_gen.ResourcePool, len(pools))
+	for i, pool := range pools {
+		grpcPools[i] = &proto_gen.ResourcePool{
+			Id:           pool.Id,
+			Name:         pool.Name,
+			Zone:         pool.Zone,
+			Floor:        pool.Floor,
+			ResourceType: pool.ResourceType,
+			Capacity:     pool.Capacity,
+			IsAvailable:  pool.IsAvailable,
 		}
 	}
-	return &proto_gen.GetSlotsToBookingResponse{Slots: grpcTimeSlots}, nil
+	return &proto_gen.ListResourcePoolsResponse{Pools: grpcPools}, nil
+}
+
+func (b *bookingAPI) SearchAvailableResources(ctx context.Context, req *proto_gen.SearchAvailableResourcesRequest) (*proto_gen.SearchAvailableResourcesResponse, error) {
+	if req.Page == 0 {
+		req.Page = 1
+	}
+	if req.BookingType == "" {
+		return nil, status.Error(codes.InvalidArgument, "resource type is required. Available resource types: workplace, parking, room, pool")
+	}
+	if req.StartTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "start time is required")
+	}
+	if req.EndTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "end time is required")
+	}
+	if !req.EndTime.AsTime().After(req.StartTime.AsTime()) {
+		return nil, status.Error(codes.InvalidArgument, "end time must be after start time")
+	}
+	resources, count, err := b.bookingService.SearchAvailableResources(ctx, req.BookingType, protoTimestampToTime(req.StartTime), protoTimestampToTime(req.EndTime), models.ResourceFilter{
+		Zone:      req.Zone,
+		Floor:     req.Floor,
+		Type:      req.Type,
+		Capacity:  req.Capacity,
+		ItemTypes: req.ItemTypes,
+	}, req.Page)
+	if err != nil {
+		b.logger.Errorf("Error searching available resources: %v", err)
+		return nil, generateErrors(err)
+	}
+	grpcResp := &proto_gen.SearchAvailableResourcesResponse{
+		Page:       req.Page,
+		PageSize:   utills.PageSize,
+		TotalCount: count/utills.PageSize + 1,
+	}
+	for _, resource := range resources {
+		grpcResp.Resources = append(grpcResp.Resources, &proto_gen.AvailableResource{
+			Id:        resource.Id,
+			Address:   resource.Address,
+			Zone:      resource.Zone,
+			Floor:     resource.Floor,
+			Number:    resource.Number,
+			Type:      resource.Type,
+			Capacity:  resource.Capacity,
+			ItemTypes: resource.ItemTypes,
+		})
+	}
+	return grpcResp, nil
+}
+
+// maxMatrixCells limits the size of a matrix row, a month with 15 minute cells.
+const maxMatrixCells = 31 * 24 * 4
+
+// maxMatrixResources limits the number of rows a client can ask for by id.
+const maxMatrixResources = 500
+
+func (b *bookingAPI) GetAvailabilityMatrix(ctx context.Context, req *proto_gen.GetAvailabilityMatrixRequest) (*proto_gen.GetAvailabilityMatrixResponse, error) {
+	if req.BookingType == "" {
+		return nil, status.Error(codes.InvalidArgument, "resource type is required. Available resource types: workplace, parking, room, pool")
+	}
+	if req.StartTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "start time is required")
+	}
+	if req.EndTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "end time is required")
+	}
+	if req.GranularityMinutes != 15 && req.GranularityMinutes != 30 && req.GranularityMinutes != 60 {
+		return nil, status.Error(codes.InvalidArgument, "granularity must be 15, 30 or 60 minutes")
+	}
+	if len(req.ResourceIds) > maxMatrixResources {
+		return nil, status.Errorf(codes.InvalidArgument, "too many resources: %d, at most %d allowed", len(req.ResourceIds), maxMatrixResources)
+	}
+	startTime, endTime := protoTimestampToTime(req.StartTime), protoTimestampToTime(req.EndTime)
+	granularity := time.Duration(req.GranularityMinutes) * time.Minute
+	if !endTime.After(startTime) {
+		return nil, status.Error(codes.InvalidArgument, "end time must be after start time")
+	}
+	cells := int64((endTime.Sub(startTime) + granularity - 1) / granularity)
+	if cells > maxMatrixCells {
+		return nil, status.Errorf(codes.InvalidArgument, "range is too long: %d cells, at most %d allowed", cells, maxMatrixCells)
+	}
+	matrix, err := b.bookingService.GetAvailabilityMatrix(ctx, req.BookingType, req.ResourceIds, models.ResourceFilter{
+		Zone:  req.Zone,
+		Floor: req.Floor,
+	}, startTime, endTime, granularity)
+	if err != nil {
+		b.logger.Errorf("Error getting availability matrix: %v", err)
+		return nil, generateErrors(err)
+	}
+	grpcResp := &proto_gen.GetAvailabilityMatrixResponse{
+		StartTime:          req.StartTime,
+		GranularityMinutes: req.GranularityMinutes,
+		Cells:              cells,
+	}
+	for _, row := range matrix {
+		grpcResp.Resources = append(grpcResp.Resources, &proto_gen.ResourceAvailability{
+			ResourceId: row.ResourceId,
+			Occupancy:  row.Occupancy,
+		})
+	}
+	return grpcResp, nil
+}
+
+func (b *bookingAPI) CreateRecurringBooking(ctx context.Context, req *proto_gen.CreateRecurringBookingRequest) (*proto_gen.CreateRecurringBookingResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+	if req.BookingType == "" {
+		return nil, status.Error(codes.InvalidArgument, "resource type is required. Available resource types: workplace, parking, room, pool")
+	}
+	if req.ResourceId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "resource id is required")
+	}
+	if req.StartTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "start time is required")
+	}
+	if req.EndTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "end time is required")
+	}
+	if req.Rrule == "" {
+		return nil, status.Error(codes.InvalidArgument, "rrule is required")
+	}
+	exDates := make([]time.Time, len(req.Exdates))
+	for i, exDate := range req.Exdates {
+		exDates[i] = protoTimestampToTime(exDate)
+	}
+	b.logger.Infof("Creating recurring booking with user id: %s and resource type: %s, id: %d, rule: %s", req.UserId, req.BookingType, req.ResourceId, req.Rrule)
+	series, err := b.bookingService.CreateRecurringBooking(ctx, req.BookingType, utills.StatusPending, protoTimestampToTime(req.StartTime), protoTimestampToTime(req.EndTime), req.UserId, req.BookedBy, req.ResourceId, req.Rrule, exDates)
+	if err != nil {
+		b.logger.Errorf("Error creating recurring booking: %v", err)
+		return nil, generateErrors(err)
+	}
+	grpcResp := &proto_gen.CreateRecurringBookingResponse{
+		SeriesId: series.SeriesId,
+	}
+	for _, booking := range series.Bookings {
+		prometheus.IncrementBookingCounter(req.BookingType)
+		grpcResp.Bookings = append(grpcResp.Bookings, bookingToGrpcBooking(&booking))
+	}
+	for _, conflict := range series.Conflicts {
+		grpcResp.Conflicts = append(grpcResp.Conflicts, &proto_gen.OccurrenceConflict{
+			StartTime:            timestamppb.New(conflict.StartTime),
+			EndTime:              timestamppb.New(conflict.EndTime),
+			ConflictingBookingId: conflict.ConflictingBookingId,
+			Reason:               conflict.Reason,
+		})
+	}
+	return grpcResp, nil
+}
+
+func attendeesFromIds(userIds []string) []models.Attendee {
+	attendees := make([]models.Attendee, 0, len(userIds))
+	for _, userId := range userIds {
+		attendees = append(attendees, models.Attendee{UserId: userId})
+	}
+	return attendees
+}
+
+func cancelScope(req *proto_gen.CancelBookingRequest) string {
+	if req.WholeBundle {
+		return utills.ScopeWholeBundle
+	}
+	return seriesScope(req.Scope)
+}
+
+func seriesScope(scope proto_gen.SeriesScope) string {
+	switch scope {
+	case proto_gen.SeriesScope_SERIES_SCOPE_THIS_AND_FOLLOWING:
+		return utills.ScopeThisAndFollowing
+	case proto_gen.SeriesScope_SERIES_SCOPE_WHOLE_SERIES:
+		return utills.ScopeWholeSeries
+	default:
+		return utills.ScopeThisOccurrence
+	}
+}
+
+const grpcStatusPrefix = "BOOKING_STATUS_"
+
+func statusToGrpc(status string) proto_gen.BookingStatus {
+	return proto_gen.BookingStatus(proto_gen.BookingStatus_value[grpcStatusPrefix+status])
+}
+
+// statusFromGrpc returns an empty status for UNSPECIFIED, which means "do not change".
+func statusFromGrpc(status proto_gen.BookingStatus) string {
+	if status == proto_gen.BookingStatus_BOOKING_STATUS_UNSPECIFIED {
+		return ""
+	}
+	return strings.TrimPrefix(status.String(), grpcStatusPrefix)
 }
 
 func bookingToGrpcBooking(model *models.Booking) *proto_gen.Booking {
-	return &proto_gen.Booking{
-		Id:         model.BookingId,
-		UserId:     model.UserId,
-		ResourceId: model.ResourceId,
-		StartTime:  timestamppb.New(model.StartTime),
-		EndTime:    timestamppb.New(model.EndTime),
-		Status:     model.Status,
-		CreatedAt:  timestamppb.New(model.CreatedAt),
-		UpdatedAt:  timestamppb.New(model.UpdatedAt),
+	booking := &proto_gen.Booking{
+		Id:           model.BookingId,
+		UserId:       model.UserId,
+		ResourceId:   model.ResourceId,
+		StartTime:    timestamppb.New(model.StartTime),
+		EndTime:      timestamppb.New(model.EndTime),
+		Status:       statusToGrpc(model.Status),
+		SeriesId:     model.SeriesId,
+		CreatedAt:    timestamppb.New(model.CreatedAt),
+		UpdatedAt:    timestamppb.New(model.UpdatedAt),
+		LicencePlate: model.LicencePlate,
+		CancelledBy:  model.CancelledBy,
+		CancelReason: model.CancelReason,
+		BundleId:     model.BundleId,
+		TimeZone:     model.TimeZone,
+		BookedBy:     model.BookedBy,
+	}
+	for _, attendee := range model.Attendees {
+		booking.Attendees = append(booking.Attendees, &proto_gen.Attendee{
+			UserId: attendee.UserId,
+			Status: proto_gen.AttendeeStatus(proto_gen.AttendeeStatus_value["ATTENDEE_STATUS_"+attendee.Status]),
+		})
+	}
+	if model.CancelledAt != nil {
+		booking.CancelledAt = timestamppb.New(*model.CancelledAt)
 	}
+	return booking
 }
 
 func protoTimestampToTime(ts *timestamppb.Timestamp) time.Time {
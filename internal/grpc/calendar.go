@@ -0,0 +1,113 @@
+package my_grpc
+
+import (
+	"context"
+	"github.com/pedroxer/booking-service/internal/models"
+	proto_gen "github.com/pedroxer/booking-service/internal/proto_gen/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+func (b *bookingAPI) CreateBlackout(ctx context.Context, req *proto_gen.CreateBlackoutRequest) (*proto_gen.Blackout, error) {
+	if req.BookingType == "" {
+		return nil, status.Error(codes.InvalidArgument, "resource type is required. Available resource types: workplace, parking, room, pool")
+	}
+	if req.ResourceId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "resource id is required")
+	}
+	if req.StartTime == nil || req.EndTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "start time and end time are required")
+	}
+	if !req.EndTime.AsTime().After(req.StartTime.AsTime()) {
+		return nil, status.Error(codes.InvalidArgument, "end time must be after start time")
+	}
+	blackout, err := b.bookingService.CreateBlackout(ctx, models.Blackout{
+		BookingType: req.BookingType,
+		ResourceId:  req.ResourceId,
+		StartTime:   protoTimestampToTime(req.StartTime),
+		EndTime:     protoTimestampToTime(req.EndTime),
+		Reason:      req.Reason,
+	})
+	if err != nil {
+		b.logger.Errorf("Error creating blackout: %v", err)
+		return nil, generateErrors(err)
+	}
+	return &proto_gen.Blackout{
+		Id:          blackout.Id,
+		BookingType: blackout.BookingType,
+		ResourceId:  blackout.ResourceId,
+		StartTime:   timestamppb.New(blackout.StartTime),
+		EndTime:     timestamppb.New(blackout.EndTime),
+		Reason:      blackout.Reason,
+		CreatedAt:   timestamppb.New(blackout.CreatedAt),
+	}, nil
+}
+
+func (b *bookingAPI) DeleteBlackout(ctx context.Context, req *proto_gen.DeleteBlackoutRequest) (*proto_gen.DeleteBlackoutResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "blackout id is required")
+	}
+	success, err := b.bookingService.DeleteBlackout(ctx, req.Id)
+	if err != nil {
+		b.logger.Errorf("Error deleting blackout: %v", err)
+		return &proto_gen.DeleteBlackoutResponse{Success: success}, generateErrors(err)
+	}
+	return &proto_gen.DeleteBlackoutResponse{Success: success}, nil
+}
+
+func (b *bookingAPI) GetOpeningHours(ctx context.Context, req *proto_gen.GetOpeningHoursRequest) (*proto_gen.ZoneOpeningHours, error) {
+	if req.Zone == "" {
+		return nil, status.Error(codes.InvalidArgument, "zone is required")
+	}
+	hours, err := b.bookingService.GetOpeningHours(ctx, req.Zone)
+	if err != nil {
+		b.logger.Errorf("Error getting opening hours: %v", err)
+		return nil, generateErrors(err)
+	}
+	return zoneOpeningHoursToProto(req.Zone, hours), nil
+}
+
+func (b *bookingAPI) SetOpeningHours(ctx context.Context, req *proto_gen.SetOpeningHoursRequest) (*proto_gen.ZoneOpeningHours, error) {
+	if req.Zone == "" {
+		return nil, status.Error(codes.InvalidArgument, "zone is required")
+	}
+	hours := make([]models.OpeningHours, 0, len(req.Days))
+	seen := make(map[int64]bool, len(req.Days))
+	for _, day := range req.Days {
+		if day.Weekday < 0 || day.Weekday > 6 {
+			return nil, status.Error(codes.InvalidArgument, "weekday must be between 0 (Sunday) and 6 (Saturday)")
+		}
+		if seen[day.Weekday] {
+			return nil, status.Errorf(codes.InvalidArgument, "weekday %d is given twice", day.Weekday)
+		}
+		seen[day.Weekday] = true
+		if day.OpensMinute < 0 || day.ClosesMinute > 24*60 || day.ClosesMinute <= day.OpensMinute {
+			return nil, status.Errorf(codes.InvalidArgument, "weekday %d must open before it closes, within 0-1440 minutes", day.Weekday)
+		}
+		hours = append(hours, models.OpeningHours{
+			Weekday:  time.Weekday(day.Weekday),
+			OpensAt:  time.Duration(day.OpensMinute) * time.Minute,
+			ClosesAt: time.Duration(day.ClosesMinute) * time.Minute,
+		})
+	}
+	hours, err := b.bookingService.SetOpeningHours(ctx, req.Zone, hours)
+	if err != nil {
+		b.logger.Errorf("Error setting opening hours: %v", err)
+		return nil, generateErrors(err)
+	}
+	return zoneOpeningHoursToProto(req.Zone, hours), nil
+}
+
+func zoneOpeningHoursToProto(zone string, hours []models.OpeningHours) *proto_gen.ZoneOpeningHours {
+	days := make([]*proto_gen.OpeningHours, 0, len(hours))
+	for _, day := range hours {
+		days = append(days, &proto_gen.OpeningHours{
+			Weekday:      int64(day.Weekday),
+			OpensMinute:  int64(day.OpensAt / time.Minute),
+			ClosesMinute: int64(day.ClosesAt / time.Minute),
+		})
+	}
+	return &proto_gen.ZoneOpeningHours{Zone: zone, Days: days}
+}
@@ -0,0 +1,74 @@
+package my_grpc
+
+import (
+	"context"
+	"github.com/pedroxer/booking-service/internal/models"
+	proto_gen "github.com/pedroxer/booking-service/internal/proto_gen/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (b *bookingAPI) CreateDelegation(ctx context.Context, req *proto_gen.CreateDelegationRequest) (*proto_gen.Delegation, error) {
+	if req.DelegateId == "" || req.PrincipalId == "" {
+		return nil, status.Error(codes.InvalidArgument, "delegate id and principal id are required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+	if req.DelegateId == req.PrincipalId {
+		return nil, status.Error(codes.InvalidArgument, "user can not delegate to themselves")
+	}
+	b.logger.Infof("User %s delegates %q bookings to %s", req.PrincipalId, req.BookingType, req.DelegateId)
+	delegation, err := b.bookingService.CreateDelegation(ctx, req.UserId, models.Delegation{
+		DelegateId:  req.DelegateId,
+		PrincipalId: req.PrincipalId,
+		BookingType: req.BookingType,
+	})
+	if err != nil {
+		b.logger.Errorf("Error creating delegation: %v", err)
+		return nil, generateErrors(err)
+	}
+	return delegationToGrpc(delegation), nil
+}
+
+func (b *bookingAPI) DeleteDelegation(ctx context.Context, req *proto_gen.DeleteDelegationRequest) (*proto_gen.DeleteDelegationResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "delegation id is required")
+	}
+	if req.PrincipalId == "" {
+		return nil, status.Error(codes.InvalidArgument, "principal id is required")
+	}
+	success, err := b.bookingService.DeleteDelegation(ctx, req.Id, req.PrincipalId)
+	if err != nil {
+		b.logger.Errorf("Error deleting delegation: %v", err)
+		return nil, generateErrors(err)
+	}
+	return &proto_gen.DeleteDelegationResponse{Success: success}, nil
+}
+
+func (b *bookingAPI) ListDelegations(ctx context.Context, req *proto_gen.ListDelegationsRequest) (*proto_gen.ListDelegationsResponse, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+	delegations, err := b.bookingService.ListDelegations(ctx, req.UserId)
+	if err != nil {
+		b.logger.Errorf("Error listing delegations: %v", err)
+		return nil, generateErrors(err)
+	}
+	resp := &proto_gen.ListDelegationsResponse{}
+	for _, delegation := range delegations {
+		resp.Delegations = append(resp.Delegations, delegationToGrpc(delegation))
+	}
+	return resp, nil
+}
+
+func delegationToGrpc(delegation models.Delegation) *proto_gen.Delegation {
+	return &proto_gen.Delegation{
+		Id:          delegation.Id,
+		DelegateId:  delegation.DelegateId,
+		PrincipalId: delegation.PrincipalId,
+		BookingType: delegation.BookingType,
+		CreatedAt:   timestamppb.New(delegation.CreatedAt),
+	}
+}
@@ -3,15 +3,100 @@ package my_grpc
 import (
 	"errors"
 	"github.com/pedroxer/booking-service/internal/utills"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"strconv"
+	"strings"
+	"time"
 )
 
+const errorDomain = "booking-service"
+
 func generateErrors(err error) error {
 	switch true {
 	case errors.Is(err, utills.ErrNoRows):
 		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, utills.ErrNotBookingOwner), errors.Is(err, utills.ErrNotDelegate), errors.Is(err, utills.ErrNotPrincipal):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, utills.ErrInvalidRRule), errors.Is(err, utills.ErrInvalidTimeRange), errors.Is(err, utills.ErrTransferToOwner):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, utills.ErrResourceUnavailable), errors.Is(err, utills.ErrOutsideCheckInWindow),
+		errors.Is(err, utills.ErrInvalidTransition), errors.Is(err, utills.ErrRoomCapacityExceeded),
+		errors.Is(err, utills.ErrResourceClosed), errors.Is(err, utills.ErrBookingNotActive):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, utills.ErrPolicyViolation):
+		return policyViolationError(err)
+	case errors.Is(err, utills.ErrBookingConflict):
+		return bookingConflictError(err)
 	default:
 		return status.Error(codes.Internal, err.Error())
 	}
 }
+
+func policyViolationError(err error) error {
+	st := status.New(codes.FailedPrecondition, err.Error())
+	var violation *utills.PolicyViolationError
+	if !errors.As(err, &violation) {
+		return st.Err()
+	}
+	detailed, detailsErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   violation.Reason,
+		Domain:   errorDomain,
+		Metadata: map[string]string{"limit": violation.Limit},
+	})
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func bookingConflictError(err error) error {
+	st := status.New(codes.AlreadyExists, err.Error())
+	if errors.Is(err, utills.ErrPoolFull) {
+		detailed, detailsErr := st.WithDetails(&errdetails.ErrorInfo{
+			Reason: "POOL_FULL",
+			Domain: errorDomain,
+		})
+		if detailsErr != nil {
+			return st.Err()
+		}
+		return detailed.Err()
+	}
+	var extension *utills.ExtensionConflictError
+	if errors.As(err, &extension) {
+		alternatives := make([]string, len(extension.AlternativeResourceIds))
+		for i, id := range extension.AlternativeResourceIds {
+			alternatives[i] = strconv.FormatInt(id, 10)
+		}
+		detailed, detailsErr := st.WithDetails(&errdetails.ErrorInfo{
+			Reason: "EXTENSION_CONFLICT",
+			Domain: errorDomain,
+			Metadata: map[string]string{
+				"booking_id":               strconv.FormatInt(extension.BookingId, 10),
+				"max_end_time":             extension.MaxEndTime.Format(time.RFC3339),
+				"max_extension_minutes":    strconv.FormatInt(int64(extension.MaxExtension/time.Minute), 10),
+				"alternative_resource_ids": strings.Join(alternatives, ","),
+			},
+		})
+		if detailsErr != nil {
+			return st.Err()
+		}
+		return detailed.Err()
+	}
+	var conflict *utills.BookingConflictError
+	if !errors.As(err, &conflict) {
+		return st.Err()
+	}
+	detailed, detailsErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: "BOOKING_CONFLICT",
+		Domain: errorDomain,
+		Metadata: map[string]string{
+			"booking_id": strconv.FormatInt(conflict.BookingId, 10),
+		},
+	})
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,85 @@
+package my_grpc
+
+import (
+	"context"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/prometheus"
+	proto_gen "github.com/pedroxer/booking-service/internal/proto_gen/protos"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+func (b *bookingAPI) CreateGuestBooking(ctx context.Context, req *proto_gen.CreateGuestBookingRequest) (*proto_gen.GuestBooking, error) {
+	if req.HostUserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "host user id is required")
+	}
+	if req.BookingType != utills.WorkplaceType && req.BookingType != utills.ParkingType && req.BookingType != utills.RoomType {
+		return nil, status.Error(codes.InvalidArgument, "resource type is required. Available resource types: workplace, parking, room")
+	}
+	if req.ResourceId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "resource id is required")
+	}
+	if req.StartTime == nil || req.EndTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "start time and end time are required")
+	}
+	if req.GuestName == "" {
+		return nil, status.Error(codes.InvalidArgument, "guest name is required")
+	}
+	b.logger.Infof("Creating guest booking with host %s and resource type: %s, id: %d", req.HostUserId, req.BookingType, req.ResourceId)
+	visit, err := b.bookingService.CreateGuestBooking(ctx, req.BookingType, models.Booking{
+		UserId:       req.HostUserId,
+		ResourceId:   req.ResourceId,
+		StartTime:    protoTimestampToTime(req.StartTime),
+		EndTime:      protoTimestampToTime(req.EndTime),
+		Status:       utills.StatusPending,
+		LicencePlate: req.LicencePlate,
+		BookedBy:     req.BookedBy,
+	}, models.Guest{
+		Name:    req.GuestName,
+		Email:   req.GuestEmail,
+		Company: req.GuestCompany,
+	})
+	if err != nil {
+		b.logger.Errorf("Error creating guest booking: %v", err)
+		return nil, generateErrors(err)
+	}
+	prometheus.IncrementBookingCounter(req.BookingType)
+	return guestBookingToGrpc(visit), nil
+}
+
+func (b *bookingAPI) ListVisitors(ctx context.Context, req *proto_gen.ListVisitorsRequest) (*proto_gen.ListVisitorsResponse, error) {
+	date := time.Now()
+	if req.Date != nil {
+		date = req.Date.AsTime()
+	}
+	visitors, err := b.bookingService.ListVisitors(ctx, date, req.Zone)
+	if err != nil {
+		b.logger.Errorf("Error listing visitors: %v", err)
+		return nil, generateErrors(err)
+	}
+	resp := &proto_gen.ListVisitorsResponse{}
+	for _, visit := range visitors {
+		resp.Visitors = append(resp.Visitors, guestBookingToGrpc(visit))
+	}
+	return resp, nil
+}
+
+func guestBookingToGrpc(visit models.GuestBooking) *proto_gen.GuestBooking {
+	guest := &proto_gen.Guest{
+		Name:        visit.Guest.Name,
+		Email:       visit.Guest.Email,
+		Company:     visit.Guest.Company,
+		CheckInCode: visit.Guest.CheckInCode,
+	}
+	if visit.Guest.CheckedInAt != nil {
+		guest.CheckedInAt = timestamppb.New(*visit.Guest.CheckedInAt)
+	}
+	return &proto_gen.GuestBooking{
+		Booking:     bookingToGrpcBooking(&visit.Booking),
+		BookingType: visit.BookingType,
+		Guest:       guest,
+	}
+}
@@ -0,0 +1,52 @@
+package my_grpc
+
+import (
+	"context"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/prometheus"
+	proto_gen "github.com/pedroxer/booking-service/internal/proto_gen/protos"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (b *bookingAPI) CreateTeamBooking(ctx context.Context, req *proto_gen.CreateTeamBookingRequest) (*proto_gen.CreateTeamBookingResponse, error) {
+	if len(req.UserIds) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user ids are required")
+	}
+	if req.BookedBy == "" {
+		return nil, status.Error(codes.InvalidArgument, "booked by is required")
+	}
+	if req.Zone == "" && req.Floor == 0 {
+		return nil, status.Error(codes.InvalidArgument, "zone or floor is required")
+	}
+	if req.StartTime == nil || req.EndTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "start time and end time are required")
+	}
+	if !req.EndTime.AsTime().After(req.StartTime.AsTime()) {
+		return nil, status.Error(codes.InvalidArgument, "end time must be after start time")
+	}
+	b.logger.Infof("Creating team booking for %d users by %s in zone %q, floor %d", len(req.UserIds), req.BookedBy, req.Zone, req.Floor)
+	team, err := b.bookingService.CreateTeamBooking(ctx, req.BookedBy, req.UserIds,
+		protoTimestampToTime(req.StartTime), protoTimestampToTime(req.EndTime), models.ResourceFilter{
+			Zone:  req.Zone,
+			Floor: req.Floor,
+			Type:  req.Type,
+		})
+	if err != nil {
+		b.logger.Errorf("Error creating team booking: %v", err)
+		return nil, generateErrors(err)
+	}
+	resp := &proto_gen.CreateTeamBookingResponse{}
+	for _, booking := range team.Bookings {
+		prometheus.IncrementBookingCounter(utills.WorkplaceType)
+		resp.Bookings = append(resp.Bookings, bookingToGrpcBooking(&booking))
+	}
+	for _, member := range team.Unseated {
+		resp.Unseated = append(resp.Unseated, &proto_gen.UnseatedMember{
+			UserId: member.UserId,
+			Reason: member.Reason,
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,86 @@
+package my_grpc
+
+import (
+	"context"
+	"github.com/pedroxer/booking-service/internal/models"
+	proto_gen "github.com/pedroxer/booking-service/internal/proto_gen/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (b *bookingAPI) MoveBooking(ctx context.Context, req *proto_gen.MoveBookingRequest) (*proto_gen.Booking, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "booking id is required")
+	}
+	if req.BookingType == "" {
+		return nil, status.Error(codes.InvalidArgument, "resource type is required. Available resource types: workplace, parking, room, pool")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+	if req.ResourceId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "resource id is required")
+	}
+	b.logger.Infof("moving booking %s with id %d to resource %d", req.BookingType, req.Id, req.ResourceId)
+	booking, err := b.bookingService.MoveBooking(ctx, req.BookingType, req.Id, req.UserId, req.ResourceId)
+	if err != nil {
+		b.logger.Errorf("Error moving booking: %v", err)
+		return nil, generateErrors(err)
+	}
+	return bookingToGrpcBooking(&booking), nil
+}
+
+func (b *bookingAPI) TransferBooking(ctx context.Context, req *proto_gen.TransferBookingRequest) (*proto_gen.TransferBookingResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "booking id is required")
+	}
+	if req.BookingType == "" {
+		return nil, status.Error(codes.InvalidArgument, "resource type is required. Available resource types: workplace, parking, room, pool")
+	}
+	if req.UserId == "" || req.ToUserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id and recipient user id are required")
+	}
+	if req.UserId == req.ToUserId {
+		return nil, status.Error(codes.InvalidArgument, "booking can not be transferred to its owner")
+	}
+	b.logger.Infof("transferring booking %s with id %d from %s to %s", req.BookingType, req.Id, req.UserId, req.ToUserId)
+	booking, transfer, err := b.bookingService.TransferBooking(ctx, req.BookingType, req.Id, req.UserId, req.ToUserId, req.RequireAcceptance)
+	if err != nil {
+		b.logger.Errorf("Error transferring booking: %v", err)
+		return nil, generateErrors(err)
+	}
+	resp := &proto_gen.TransferBookingResponse{Booking: bookingToGrpcBooking(&booking)}
+	if transfer.Id != 0 {
+		resp.Transfer = transferToGrpc(transfer)
+	}
+	return resp, nil
+}
+
+func (b *bookingAPI) RespondToTransfer(ctx context.Context, req *proto_gen.RespondToTransferRequest) (*proto_gen.Booking, error) {
+	if req.TransferId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "transfer id is required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+	b.logger.Infof("User %s responds to transfer %d: %t", req.UserId, req.TransferId, req.Accept)
+	booking, err := b.bookingService.RespondToTransfer(ctx, req.TransferId, req.UserId, req.Accept)
+	if err != nil {
+		b.logger.Errorf("Error responding to transfer: %v", err)
+		return nil, generateErrors(err)
+	}
+	return bookingToGrpcBooking(&booking), nil
+}
+
+func transferToGrpc(transfer models.BookingTransfer) *proto_gen.BookingTransfer {
+	return &proto_gen.BookingTransfer{
+		Id:          transfer.Id,
+		BookingType: transfer.BookingType,
+		BookingId:   transfer.BookingId,
+		FromUserId:  transfer.FromUserId,
+		ToUserId:    transfer.ToUserId,
+		Status:      transfer.Status,
+		CreatedAt:   timestamppb.New(transfer.CreatedAt),
+	}
+}
@@ -0,0 +1,100 @@
+package my_grpc
+
+import (
+	"context"
+	"github.com/pedroxer/booking-service/internal/models"
+	proto_gen "github.com/pedroxer/booking-service/internal/proto_gen/protos"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (b *bookingAPI) JoinWaitlist(ctx context.Context, req *proto_gen.JoinWaitlistRequest) (*proto_gen.WaitlistEntry, error) {
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+	if req.BookingType == "" {
+		return nil, status.Error(codes.InvalidArgument, "resource type is required. Available resource types: workplace, parking, room, pool")
+	}
+	if req.ResourceId == 0 && req.Zone == "" {
+		return nil, status.Error(codes.InvalidArgument, "resource id or zone is required")
+	}
+	if req.StartTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "start time is required")
+	}
+	if req.EndTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "end time is required")
+	}
+	if !req.EndTime.AsTime().After(req.StartTime.AsTime()) {
+		return nil, status.Error(codes.InvalidArgument, "end time must be after start time")
+	}
+	b.logger.Infof("user %s joins waitlist for %s, resource id: %d, zone: %s", req.UserId, req.BookingType, req.ResourceId, req.Zone)
+	entry, err := b.bookingService.JoinWaitlist(ctx, models.WaitlistEntry{
+		UserId:      req.UserId,
+		BookingType: req.BookingType,
+		ResourceId:  req.ResourceId,
+		Zone:        req.Zone,
+		Floor:       req.Floor,
+		StartTime:   protoTimestampToTime(req.StartTime),
+		EndTime:     protoTimestampToTime(req.EndTime),
+		Priority:    req.Priority,
+	})
+	if err != nil {
+		b.logger.Errorf("Error joining waitlist: %v", err)
+		return nil, generateErrors(err)
+	}
+	return waitlistEntryToGrpc(&entry), nil
+}
+
+func (b *bookingAPI) LeaveWaitlist(ctx context.Context, req *proto_gen.LeaveWaitlistRequest) (*proto_gen.LeaveWaitlistResponse, error) {
+	if req.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "waitlist entry id is required")
+	}
+	if req.UserId == "" {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+	success, err := b.bookingService.LeaveWaitlist(ctx, req.Id, req.UserId)
+	if err != nil {
+		b.logger.Errorf("Error leaving waitlist: %v", err)
+		return &proto_gen.LeaveWaitlistResponse{Success: success}, generateErrors(err)
+	}
+	return &proto_gen.LeaveWaitlistResponse{Success: success}, nil
+}
+
+func (b *bookingAPI) ListWaitlist(ctx context.Context, req *proto_gen.ListWaitlistRequest) (*proto_gen.ListWaitlistResponse, error) {
+	if req.Page == 0 {
+		req.Page = 1
+	}
+	entries, count, err := b.bookingService.ListWaitlist(ctx, req.BookingType, req.UserId, req.ResourceId, req.Page)
+	if err != nil {
+		b.logger.Errorf("Error getting waitlist: %v", err)
+		return nil, generateErrors(err)
+	}
+	grpcResp := &proto_gen.ListWaitlistResponse{
+		Page:       req.Page,
+		PageSize:   utills.PageSize,
+		TotalCount: count/utills.PageSize + 1,
+	}
+	for _, entry := range entries {
+		grpcResp.Entries = append(grpcResp.Entries, waitlistEntryToGrpc(&entry))
+	}
+	return grpcResp, nil
+}
+
+func waitlistEntryToGrpc(model *models.WaitlistEntry) *proto_gen.WaitlistEntry {
+	return &proto_gen.WaitlistEntry{
+		Id:          model.Id,
+		UserId:      model.UserId,
+		BookingType: model.BookingType,
+		ResourceId:  model.ResourceId,
+		Zone:        model.Zone,
+		Floor:       model.Floor,
+		StartTime:   timestamppb.New(model.StartTime),
+		EndTime:     timestamppb.New(model.EndTime),
+		Priority:    model.Priority,
+		Status:      model.Status,
+		BookingId:   model.BookingId,
+		CreatedAt:   timestamppb.New(model.CreatedAt),
+	}
+}
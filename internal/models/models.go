@@ -9,12 +9,209 @@ type Booking struct {
 	StartTime  time.Time `json:"start_time"`
 	EndTime    time.Time `json:"end_time"`
 	Status     string    `json:"status"`
+	SeriesId   int64     `json:"series_id"`
 	CreatedAt  time.Time `json:"created_at"`
 	UpdatedAt  time.Time `json:"updated_at"`
+
+	LicencePlate string     `json:"licence_plate"` // только для parking
+	CancelledAt  *time.Time `json:"cancelled_at"`
+	CancelledBy  string     `json:"cancelled_by"`
+	CancelReason string     `json:"cancel_reason"`
+	BundleId     int64      `json:"bundle_id"`
+	Attendees    []Attendee `json:"attendees"` // только для room, организатор - UserId
+	TimeZone     string     `json:"time_zone"` // IANA зона офиса на момент создания
+	// Ресурс занят с учётом буферов до и после брони, видимые клиенту времена - StartTime/EndTime
+	BlockedStart time.Time `json:"blocked_start"`
+	BlockedEnd   time.Time `json:"blocked_end"`
+	BookedBy     string    `json:"booked_by"` // кто забронировал за владельца, пусто - сам владелец
+}
+
+// Guest is an external visitor, the booking made for them belongs to the host.
+type Guest struct {
+	Name        string     `json:"name"`
+	Email       string     `json:"email"`
+	Company     string     `json:"company"`
+	CheckInCode string     `json:"check_in_code"` // одноразовый код для ApproveByQRBooking
+	CheckedInAt *time.Time `json:"checked_in_at"`
+}
+
+type GuestBooking struct {
+	BookingType string  `json:"booking_type"`
+	Booking     Booking `json:"booking"`
+	Guest       Guest   `json:"guest"`
+}
+
+// TeamBooking is the result of seating a team together, Unseated lists members left without a desk.
+type TeamBooking struct {
+	Bookings []Booking        `json:"bookings"`
+	Unseated []UnseatedMember `json:"unseated"`
+}
+
+type UnseatedMember struct {
+	UserId string `json:"user_id"`
+	Reason string `json:"reason"`
+}
+
+// Delegation lets DelegateId book and cancel bookings for PrincipalId, of one type or, with
+// an empty BookingType, of all types.
+type Delegation struct {
+	Id          int64     `json:"id"`
+	DelegateId  string    `json:"delegate_id"`
+	PrincipalId string    `json:"principal_id"`
+	BookingType string    `json:"booking_type"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Buffer is the time a resource stays blocked before and after each booking, e.g. for cleaning.
+type Buffer struct {
+	Before time.Duration `json:"before"`
+	After  time.Duration `json:"after"`
+}
+
+type Attendee struct {
+	UserId string `json:"user_id"`
+	Status string `json:"status"`
+}
+
+// BundleBooking is a booking of a bundle, bundles mix bookings of different types.
+type BundleBooking struct {
+	BookingType string  `json:"booking_type"`
+	Booking     Booking `json:"booking"`
+}
+
+// CheckOut is a booking finished early. CheckedInAt is when the resource was actually taken:
+// the check-in time, or the booking start if the user came in advance.
+type CheckOut struct {
+	Booking     Booking
+	CheckedInAt time.Time
+	PlannedEnd  time.Time
 }
 
 type TimeSlot struct {
-	StartTime time.Time `json:"start_time"`
-	EndTime   time.Time `json:"end_time"`
-	Busy      bool      `json:"busy"`
+	StartTime         time.Time `json:"start_time"`
+	EndTime           time.Time `json:"end_time"`
+	Busy              bool      `json:"busy"`
+	Closed            bool      `json:"closed"`
+	RemainingCapacity int64     `json:"remaining_capacity"`
+}
+
+// OpeningHours of a zone on a weekday, as offsets from midnight.
+type OpeningHours struct {
+	Weekday  time.Weekday  `json:"weekday"`
+	OpensAt  time.Duration `json:"opens_at"`
+	ClosesAt time.Duration `json:"closes_at"`
+}
+
+type Holiday struct {
+	Date time.Time `json:"date"`
+	Name string    `json:"name"`
+}
+
+// Blackout is a period when the resource cannot be booked, e.g. a planned renovation.
+type Blackout struct {
+	Id          int64     `json:"id"`
+	BookingType string    `json:"booking_type"`
+	ResourceId  int64     `json:"resource_id"`
+	StartTime   time.Time `json:"start_time"`
+	EndTime     time.Time `json:"end_time"`
+	Reason      string    `json:"reason"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type Resource struct {
+	Id                int64    `json:"id"`
+	Address           string   `json:"address"`
+	Zone              string   `json:"zone"`
+	Floor             int64    `json:"floor"`
+	Number            int64    `json:"number"`
+	Type              string   `json:"type"`
+	Capacity          int64    `json:"capacity"`
+	IsAvailable       bool     `json:"is_available"`
+	MaintenanceStatus string   `json:"maintenance_status"`
+	ItemTypes         []string `json:"item_types"`
+}
+
+// ResourceFilter selects resources for search, zero values match anything.
+type ResourceFilter struct {
+	Zone      string   `json:"zone"`
+	Floor     int64    `json:"floor"` // только для workplace
+	Type      string   `json:"type"`
+	Capacity  int64    `json:"capacity"`   // минимальная вместимость
+	ItemTypes []string `json:"item_types"` // только для workplace
+}
+
+// ResourceOccupancy is a row of the availability matrix, a bit per cell, set when the cell is busy.
+type ResourceOccupancy struct {
+	ResourceId int64  `json:"resource_id"`
+	Occupancy  []byte `json:"occupancy"`
+}
+
+// Policy limits bookings of one type, zero values mean no limit. ZoneGroups maps a zone
+// to the user groups allowed to book it, zones that are not listed are open to everyone.
+type Policy struct {
+	MaxDuration       time.Duration       `json:"max_duration"`
+	MaxAdvance        time.Duration       `json:"max_advance"`
+	MinLeadTime       time.Duration       `json:"min_lead_time"`
+	MaxActiveBookings int64               `json:"max_active_bookings"`
+	MaxDaysPerWeek    int64               `json:"max_days_per_week"`
+	ZoneGroups        map[string][]string `json:"zone_groups"`
+}
+
+// ResourcePool is a group of interchangeable places, a booking takes any one of them.
+type ResourcePool struct {
+	Id           int64  `json:"id"`
+	Name         string `json:"name"`
+	Zone         string `json:"zone"`
+	Floor        int64  `json:"floor"`
+	ResourceType string `json:"resource_type"`
+	Capacity     int64  `json:"capacity"`
+	IsAvailable  bool   `json:"is_available"`
+}
+
+type OccurrenceConflict struct {
+	StartTime            time.Time `json:"start_time"`
+	EndTime              time.Time `json:"end_time"`
+	ConflictingBookingId int64     `json:"conflicting_booking_id"`
+	Reason               string    `json:"reason"`
+}
+
+type BookingSeries struct {
+	SeriesId  int64                `json:"series_id"`
+	Bookings  []Booking            `json:"bookings"`
+	Conflicts []OccurrenceConflict `json:"conflicts"`
+}
+
+type WaitlistEntry struct {
+	Id          int64     `json:"id"`
+	UserId      string    `json:"user_id"`
+	BookingType string    `json:"booking_type"`
+	ResourceId  int64     `json:"resource_id"`
+	Zone        string    `json:"zone"`
+	Floor       int64     `json:"floor"`
+	StartTime   time.Time `json:"start_time"`
+	EndTime     time.Time `json:"end_time"`
+	Priority    int64     `json:"priority"`
+	Status      string    `json:"status"`
+	BookingId   int64     `json:"booking_id"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// BookingTransfer is an offer to hand a booking over to another user, it waits for the recipient to accept it.
+type BookingTransfer struct {
+	Id          int64     `json:"id"`
+	BookingType string    `json:"booking_type"`
+	BookingId   int64     `json:"booking_id"`
+	FromUserId  string    `json:"from_user_id"`
+	ToUserId    string    `json:"to_user_id"`
+	Status      string    `json:"status"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type BookingEvent struct {
+	EventType   string            `json:"event_type"`
+	UserId      string            `json:"user_id"`
+	BookingType string            `json:"booking_type"`
+	BookingId   int64             `json:"booking_id"`
+	Payload     map[string]string `json:"payload"`
 }
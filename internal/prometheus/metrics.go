@@ -9,6 +9,8 @@ var rpcMetricCounter prometheus.Counter
 
 var bookingMetricGauge *prometheus.GaugeVec
 
+var releasedBookingsCounter *prometheus.CounterVec
+
 func MetricsInit() error {
 	bookingMetricGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Name:      "bookings_by_type",
@@ -29,6 +31,16 @@ func MetricsInit() error {
 	if err := prometheus.Register(rpcMetricCounter); err != nil {
 		return fmt.Errorf("couldn't register rpcMetricCounter: %v", err)
 	}
+
+	releasedBookingsCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name:      "released_bookings",
+		Subsystem: "booking",
+		Help:      "Number of bookings released automatically because nobody checked in",
+	}, []string{"zone"})
+
+	if err := prometheus.Register(releasedBookingsCounter); err != nil {
+		return fmt.Errorf("couldn't register releasedBookingsCounter: %v", err)
+	}
 	return nil
 }
 
@@ -38,3 +50,7 @@ func IncrementBookingCounter(service string) {
 func RpcMetricCounterInc() {
 	rpcMetricCounter.Inc()
 }
+
+func IncrementReleasedBookings(zone string) {
+	releasedBookingsCounter.WithLabelValues(zone).Inc()
+}
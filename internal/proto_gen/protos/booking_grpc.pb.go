@@ -26,9 +26,33 @@ type BookingServiceClient interface {
 	GetBookingById(ctx context.Context, in *GetBookingByIdRequest, opts ...grpc.CallOption) (*Booking, error)
 	GetBookings(ctx context.Context, in *GetBookingsRequest, opts ...grpc.CallOption) (*GetBookingsResponse, error)
 	UpdateBooking(ctx context.Context, in *UpdateBookingRequest, opts ...grpc.CallOption) (*Booking, error)
+	ExtendBooking(ctx context.Context, in *ExtendBookingRequest, opts ...grpc.CallOption) (*Booking, error)
 	CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error)
 	ApproveByQRBooking(ctx context.Context, in *ApproveByQRBookingRequest, opts ...grpc.CallOption) (*ApproveByQRBookingResponse, error)
+	CheckOutBooking(ctx context.Context, in *CheckOutBookingRequest, opts ...grpc.CallOption) (*Booking, error)
+	RespondToInvitation(ctx context.Context, in *RespondToInvitationRequest, opts ...grpc.CallOption) (*Booking, error)
+	MoveBooking(ctx context.Context, in *MoveBookingRequest, opts ...grpc.CallOption) (*Booking, error)
+	TransferBooking(ctx context.Context, in *TransferBookingRequest, opts ...grpc.CallOption) (*TransferBookingResponse, error)
+	RespondToTransfer(ctx context.Context, in *RespondToTransferRequest, opts ...grpc.CallOption) (*Booking, error)
 	GetSlotsToBooking(ctx context.Context, in *GetSlotsToBookingRequest, opts ...grpc.CallOption) (*GetSlotsToBookingResponse, error)
+	ListResourcePools(ctx context.Context, in *ListResourcePoolsRequest, opts ...grpc.CallOption) (*ListResourcePoolsResponse, error)
+	CreateBlackout(ctx context.Context, in *CreateBlackoutRequest, opts ...grpc.CallOption) (*Blackout, error)
+	DeleteBlackout(ctx context.Context, in *DeleteBlackoutRequest, opts ...grpc.CallOption) (*DeleteBlackoutResponse, error)
+	GetOpeningHours(ctx context.Context, in *GetOpeningHoursRequest, opts ...grpc.CallOption) (*ZoneOpeningHours, error)
+	SetOpeningHours(ctx context.Context, in *SetOpeningHoursRequest, opts ...grpc.CallOption) (*ZoneOpeningHours, error)
+	SearchAvailableResources(ctx context.Context, in *SearchAvailableResourcesRequest, opts ...grpc.CallOption) (*SearchAvailableResourcesResponse, error)
+	GetAvailabilityMatrix(ctx context.Context, in *GetAvailabilityMatrixRequest, opts ...grpc.CallOption) (*GetAvailabilityMatrixResponse, error)
+	CreateRecurringBooking(ctx context.Context, in *CreateRecurringBookingRequest, opts ...grpc.CallOption) (*CreateRecurringBookingResponse, error)
+	CreateBookingBundle(ctx context.Context, in *CreateBookingBundleRequest, opts ...grpc.CallOption) (*BookingBundle, error)
+	JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*WaitlistEntry, error)
+	LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*LeaveWaitlistResponse, error)
+	ListWaitlist(ctx context.Context, in *ListWaitlistRequest, opts ...grpc.CallOption) (*ListWaitlistResponse, error)
+	CreateDelegation(ctx context.Context, in *CreateDelegationRequest, opts ...grpc.CallOption) (*Delegation, error)
+	DeleteDelegation(ctx context.Context, in *DeleteDelegationRequest, opts ...grpc.CallOption) (*DeleteDelegationResponse, error)
+	ListDelegations(ctx context.Context, in *ListDelegationsRequest, opts ...grpc.CallOption) (*ListDelegationsResponse, error)
+	CreateGuestBooking(ctx context.Context, in *CreateGuestBookingRequest, opts ...grpc.CallOption) (*GuestBooking, error)
+	ListVisitors(ctx context.Context, in *ListVisitorsRequest, opts ...grpc.CallOption) (*ListVisitorsResponse, error)
+	CreateTeamBooking(ctx context.Context, in *CreateTeamBookingRequest, opts ...grpc.CallOption) (*CreateTeamBookingResponse, error)
 }
 
 type bookingServiceClient struct {
@@ -75,6 +99,15 @@ func (c *bookingServiceClient) UpdateBooking(ctx context.Context, in *UpdateBook
 	return out, nil
 }
 
+func (c *bookingServiceClient) ExtendBooking(ctx context.Context, in *ExtendBookingRequest, opts ...grpc.CallOption) (*Booking, error) {
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/ExtendBooking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookingServiceClient) CancelBooking(ctx context.Context, in *CancelBookingRequest, opts ...grpc.CallOption) (*CancelBookingResponse, error) {
 	out := new(CancelBookingResponse)
 	err := c.cc.Invoke(ctx, "/BookingService.BookingService/CancelBooking", in, out, opts...)
@@ -93,6 +126,51 @@ func (c *bookingServiceClient) ApproveByQRBooking(ctx context.Context, in *Appro
 	return out, nil
 }
 
+func (c *bookingServiceClient) CheckOutBooking(ctx context.Context, in *CheckOutBookingRequest, opts ...grpc.CallOption) (*Booking, error) {
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/CheckOutBooking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) RespondToInvitation(ctx context.Context, in *RespondToInvitationRequest, opts ...grpc.CallOption) (*Booking, error) {
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/RespondToInvitation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) MoveBooking(ctx context.Context, in *MoveBookingRequest, opts ...grpc.CallOption) (*Booking, error) {
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/MoveBooking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) TransferBooking(ctx context.Context, in *TransferBookingRequest, opts ...grpc.CallOption) (*TransferBookingResponse, error) {
+	out := new(TransferBookingResponse)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/TransferBooking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) RespondToTransfer(ctx context.Context, in *RespondToTransferRequest, opts ...grpc.CallOption) (*Booking, error) {
+	out := new(Booking)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/RespondToTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *bookingServiceClient) GetSlotsToBooking(ctx context.Context, in *GetSlotsToBookingRequest, opts ...grpc.CallOption) (*GetSlotsToBookingResponse, error) {
 	out := new(GetSlotsToBookingResponse)
 	err := c.cc.Invoke(ctx, "/BookingService.BookingService/GetSlotsToBooking", in, out, opts...)
@@ -102,6 +180,168 @@ func (c *bookingServiceClient) GetSlotsToBooking(ctx context.Context, in *GetSlo
 	return out, nil
 }
 
+func (c *bookingServiceClient) ListResourcePools(ctx context.Context, in *ListResourcePoolsRequest, opts ...grpc.CallOption) (*ListResourcePoolsResponse, error) {
+	out := new(ListResourcePoolsResponse)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/ListResourcePools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreateBlackout(ctx context.Context, in *CreateBlackoutRequest, opts ...grpc.CallOption) (*Blackout, error) {
+	out := new(Blackout)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/CreateBlackout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) DeleteBlackout(ctx context.Context, in *DeleteBlackoutRequest, opts ...grpc.CallOption) (*DeleteBlackoutResponse, error) {
+	out := new(DeleteBlackoutResponse)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/DeleteBlackout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetOpeningHours(ctx context.Context, in *GetOpeningHoursRequest, opts ...grpc.CallOption) (*ZoneOpeningHours, error) {
+	out := new(ZoneOpeningHours)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/GetOpeningHours", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) SetOpeningHours(ctx context.Context, in *SetOpeningHoursRequest, opts ...grpc.CallOption) (*ZoneOpeningHours, error) {
+	out := new(ZoneOpeningHours)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/SetOpeningHours", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) SearchAvailableResources(ctx context.Context, in *SearchAvailableResourcesRequest, opts ...grpc.CallOption) (*SearchAvailableResourcesResponse, error) {
+	out := new(SearchAvailableResourcesResponse)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/SearchAvailableResources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) GetAvailabilityMatrix(ctx context.Context, in *GetAvailabilityMatrixRequest, opts ...grpc.CallOption) (*GetAvailabilityMatrixResponse, error) {
+	out := new(GetAvailabilityMatrixResponse)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/GetAvailabilityMatrix", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreateRecurringBooking(ctx context.Context, in *CreateRecurringBookingRequest, opts ...grpc.CallOption) (*CreateRecurringBookingResponse, error) {
+	out := new(CreateRecurringBookingResponse)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/CreateRecurringBooking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreateBookingBundle(ctx context.Context, in *CreateBookingBundleRequest, opts ...grpc.CallOption) (*BookingBundle, error) {
+	out := new(BookingBundle)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/CreateBookingBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) JoinWaitlist(ctx context.Context, in *JoinWaitlistRequest, opts ...grpc.CallOption) (*WaitlistEntry, error) {
+	out := new(WaitlistEntry)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/JoinWaitlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) LeaveWaitlist(ctx context.Context, in *LeaveWaitlistRequest, opts ...grpc.CallOption) (*LeaveWaitlistResponse, error) {
+	out := new(LeaveWaitlistResponse)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/LeaveWaitlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListWaitlist(ctx context.Context, in *ListWaitlistRequest, opts ...grpc.CallOption) (*ListWaitlistResponse, error) {
+	out := new(ListWaitlistResponse)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/ListWaitlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreateDelegation(ctx context.Context, in *CreateDelegationRequest, opts ...grpc.CallOption) (*Delegation, error) {
+	out := new(Delegation)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/CreateDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) DeleteDelegation(ctx context.Context, in *DeleteDelegationRequest, opts ...grpc.CallOption) (*DeleteDelegationResponse, error) {
+	out := new(DeleteDelegationResponse)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/DeleteDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListDelegations(ctx context.Context, in *ListDelegationsRequest, opts ...grpc.CallOption) (*ListDelegationsResponse, error) {
+	out := new(ListDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/ListDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreateGuestBooking(ctx context.Context, in *CreateGuestBookingRequest, opts ...grpc.CallOption) (*GuestBooking, error) {
+	out := new(GuestBooking)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/CreateGuestBooking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) ListVisitors(ctx context.Context, in *ListVisitorsRequest, opts ...grpc.CallOption) (*ListVisitorsResponse, error) {
+	out := new(ListVisitorsResponse)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/ListVisitors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bookingServiceClient) CreateTeamBooking(ctx context.Context, in *CreateTeamBookingRequest, opts ...grpc.CallOption) (*CreateTeamBookingResponse, error) {
+	out := new(CreateTeamBookingResponse)
+	err := c.cc.Invoke(ctx, "/BookingService.BookingService/CreateTeamBooking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BookingServiceServer is the server API for BookingService service.
 // All implementations must embed UnimplementedBookingServiceServer
 // for forward compatibility
@@ -110,9 +350,33 @@ type BookingServiceServer interface {
 	GetBookingById(context.Context, *GetBookingByIdRequest) (*Booking, error)
 	GetBookings(context.Context, *GetBookingsRequest) (*GetBookingsResponse, error)
 	UpdateBooking(context.Context, *UpdateBookingRequest) (*Booking, error)
+	ExtendBooking(context.Context, *ExtendBookingRequest) (*Booking, error)
 	CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error)
 	ApproveByQRBooking(context.Context, *ApproveByQRBookingRequest) (*ApproveByQRBookingResponse, error)
+	CheckOutBooking(context.Context, *CheckOutBookingRequest) (*Booking, error)
+	RespondToInvitation(context.Context, *RespondToInvitationRequest) (*Booking, error)
+	MoveBooking(context.Context, *MoveBookingRequest) (*Booking, error)
+	TransferBooking(context.Context, *TransferBookingRequest) (*TransferBookingResponse, error)
+	RespondToTransfer(context.Context, *RespondToTransferRequest) (*Booking, error)
 	GetSlotsToBooking(context.Context, *GetSlotsToBookingRequest) (*GetSlotsToBookingResponse, error)
+	ListResourcePools(context.Context, *ListResourcePoolsRequest) (*ListResourcePoolsResponse, error)
+	CreateBlackout(context.Context, *CreateBlackoutRequest) (*Blackout, error)
+	DeleteBlackout(context.Context, *DeleteBlackoutRequest) (*DeleteBlackoutResponse, error)
+	GetOpeningHours(context.Context, *GetOpeningHoursRequest) (*ZoneOpeningHours, error)
+	SetOpeningHours(context.Context, *SetOpeningHoursRequest) (*ZoneOpeningHours, error)
+	SearchAvailableResources(context.Context, *SearchAvailableResourcesRequest) (*SearchAvailableResourcesResponse, error)
+	GetAvailabilityMatrix(context.Context, *GetAvailabilityMatrixRequest) (*GetAvailabilityMatrixResponse, error)
+	CreateRecurringBooking(context.Context, *CreateRecurringBookingRequest) (*CreateRecurringBookingResponse, error)
+	CreateBookingBundle(context.Context, *CreateBookingBundleRequest) (*BookingBundle, error)
+	JoinWaitlist(context.Context, *JoinWaitlistRequest) (*WaitlistEntry, error)
+	LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*LeaveWaitlistResponse, error)
+	ListWaitlist(context.Context, *ListWaitlistRequest) (*ListWaitlistResponse, error)
+	CreateDelegation(context.Context, *CreateDelegationRequest) (*Delegation, error)
+	DeleteDelegation(context.Context, *DeleteDelegationRequest) (*DeleteDelegationResponse, error)
+	ListDelegations(context.Context, *ListDelegationsRequest) (*ListDelegationsResponse, error)
+	CreateGuestBooking(context.Context, *CreateGuestBookingRequest) (*GuestBooking, error)
+	ListVisitors(context.Context, *ListVisitorsRequest) (*ListVisitorsResponse, error)
+	CreateTeamBooking(context.Context, *CreateTeamBookingRequest) (*CreateTeamBookingResponse, error)
 	mustEmbedUnimplementedBookingServiceServer()
 }
 
@@ -132,15 +396,87 @@ func (UnimplementedBookingServiceServer) GetBookings(context.Context, *GetBookin
 func (UnimplementedBookingServiceServer) UpdateBooking(context.Context, *UpdateBookingRequest) (*Booking, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateBooking not implemented")
 }
+func (UnimplementedBookingServiceServer) ExtendBooking(context.Context, *ExtendBookingRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendBooking not implemented")
+}
 func (UnimplementedBookingServiceServer) CancelBooking(context.Context, *CancelBookingRequest) (*CancelBookingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelBooking not implemented")
 }
 func (UnimplementedBookingServiceServer) ApproveByQRBooking(context.Context, *ApproveByQRBookingRequest) (*ApproveByQRBookingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApproveByQRBooking not implemented")
 }
+func (UnimplementedBookingServiceServer) CheckOutBooking(context.Context, *CheckOutBookingRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckOutBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) RespondToInvitation(context.Context, *RespondToInvitationRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondToInvitation not implemented")
+}
+func (UnimplementedBookingServiceServer) MoveBooking(context.Context, *MoveBookingRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) TransferBooking(context.Context, *TransferBookingRequest) (*TransferBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) RespondToTransfer(context.Context, *RespondToTransferRequest) (*Booking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RespondToTransfer not implemented")
+}
 func (UnimplementedBookingServiceServer) GetSlotsToBooking(context.Context, *GetSlotsToBookingRequest) (*GetSlotsToBookingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSlotsToBooking not implemented")
 }
+func (UnimplementedBookingServiceServer) ListResourcePools(context.Context, *ListResourcePoolsRequest) (*ListResourcePoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListResourcePools not implemented")
+}
+func (UnimplementedBookingServiceServer) CreateBlackout(context.Context, *CreateBlackoutRequest) (*Blackout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBlackout not implemented")
+}
+func (UnimplementedBookingServiceServer) DeleteBlackout(context.Context, *DeleteBlackoutRequest) (*DeleteBlackoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBlackout not implemented")
+}
+func (UnimplementedBookingServiceServer) GetOpeningHours(context.Context, *GetOpeningHoursRequest) (*ZoneOpeningHours, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOpeningHours not implemented")
+}
+func (UnimplementedBookingServiceServer) SetOpeningHours(context.Context, *SetOpeningHoursRequest) (*ZoneOpeningHours, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOpeningHours not implemented")
+}
+func (UnimplementedBookingServiceServer) SearchAvailableResources(context.Context, *SearchAvailableResourcesRequest) (*SearchAvailableResourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAvailableResources not implemented")
+}
+func (UnimplementedBookingServiceServer) GetAvailabilityMatrix(context.Context, *GetAvailabilityMatrixRequest) (*GetAvailabilityMatrixResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailabilityMatrix not implemented")
+}
+func (UnimplementedBookingServiceServer) CreateRecurringBooking(context.Context, *CreateRecurringBookingRequest) (*CreateRecurringBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecurringBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) CreateBookingBundle(context.Context, *CreateBookingBundleRequest) (*BookingBundle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBookingBundle not implemented")
+}
+func (UnimplementedBookingServiceServer) JoinWaitlist(context.Context, *JoinWaitlistRequest) (*WaitlistEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinWaitlist not implemented")
+}
+func (UnimplementedBookingServiceServer) LeaveWaitlist(context.Context, *LeaveWaitlistRequest) (*LeaveWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LeaveWaitlist not implemented")
+}
+func (UnimplementedBookingServiceServer) ListWaitlist(context.Context, *ListWaitlistRequest) (*ListWaitlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWaitlist not implemented")
+}
+func (UnimplementedBookingServiceServer) CreateDelegation(context.Context, *CreateDelegationRequest) (*Delegation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDelegation not implemented")
+}
+func (UnimplementedBookingServiceServer) DeleteDelegation(context.Context, *DeleteDelegationRequest) (*DeleteDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDelegation not implemented")
+}
+func (UnimplementedBookingServiceServer) ListDelegations(context.Context, *ListDelegationsRequest) (*ListDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDelegations not implemented")
+}
+func (UnimplementedBookingServiceServer) CreateGuestBooking(context.Context, *CreateGuestBookingRequest) (*GuestBooking, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGuestBooking not implemented")
+}
+func (UnimplementedBookingServiceServer) ListVisitors(context.Context, *ListVisitorsRequest) (*ListVisitorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVisitors not implemented")
+}
+func (UnimplementedBookingServiceServer) CreateTeamBooking(context.Context, *CreateTeamBookingRequest) (*CreateTeamBookingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTeamBooking not implemented")
+}
 func (UnimplementedBookingServiceServer) mustEmbedUnimplementedBookingServiceServer() {}
 
 // UnsafeBookingServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -226,6 +562,24 @@ func _BookingService_UpdateBooking_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingService_ExtendBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ExtendBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/ExtendBooking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ExtendBooking(ctx, req.(*ExtendBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BookingService_CancelBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CancelBookingRequest)
 	if err := dec(in); err != nil {
@@ -262,6 +616,96 @@ func _BookingService_ApproveByQRBooking_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BookingService_CheckOutBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckOutBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CheckOutBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/CheckOutBooking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CheckOutBooking(ctx, req.(*CheckOutBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_RespondToInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondToInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).RespondToInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/RespondToInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).RespondToInvitation(ctx, req.(*RespondToInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_MoveBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).MoveBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/MoveBooking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).MoveBooking(ctx, req.(*MoveBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_TransferBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).TransferBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/TransferBooking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).TransferBooking(ctx, req.(*TransferBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_RespondToTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RespondToTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).RespondToTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/RespondToTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).RespondToTransfer(ctx, req.(*RespondToTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BookingService_GetSlotsToBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSlotsToBookingRequest)
 	if err := dec(in); err != nil {
@@ -280,11 +724,335 @@ func _BookingService_GetSlotsToBooking_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
-// BookingService_ServiceDesc is the grpc.ServiceDesc for BookingService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var BookingService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "BookingService.BookingService",
+func _BookingService_ListResourcePools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListResourcePoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListResourcePools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/ListResourcePools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListResourcePools(ctx, req.(*ListResourcePoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreateBlackout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBlackoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateBlackout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/CreateBlackout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateBlackout(ctx, req.(*CreateBlackoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_DeleteBlackout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBlackoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).DeleteBlackout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/DeleteBlackout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).DeleteBlackout(ctx, req.(*DeleteBlackoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetOpeningHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOpeningHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetOpeningHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/GetOpeningHours",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetOpeningHours(ctx, req.(*GetOpeningHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_SetOpeningHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOpeningHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).SetOpeningHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/SetOpeningHours",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).SetOpeningHours(ctx, req.(*SetOpeningHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_SearchAvailableResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAvailableResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).SearchAvailableResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/SearchAvailableResources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).SearchAvailableResources(ctx, req.(*SearchAvailableResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_GetAvailabilityMatrix_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailabilityMatrixRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).GetAvailabilityMatrix(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/GetAvailabilityMatrix",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).GetAvailabilityMatrix(ctx, req.(*GetAvailabilityMatrixRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreateRecurringBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecurringBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateRecurringBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/CreateRecurringBooking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateRecurringBooking(ctx, req.(*CreateRecurringBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreateBookingBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBookingBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateBookingBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/CreateBookingBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateBookingBundle(ctx, req.(*CreateBookingBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_JoinWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).JoinWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/JoinWaitlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).JoinWaitlist(ctx, req.(*JoinWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_LeaveWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaveWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).LeaveWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/LeaveWaitlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).LeaveWaitlist(ctx, req.(*LeaveWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListWaitlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWaitlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListWaitlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/ListWaitlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListWaitlist(ctx, req.(*ListWaitlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreateDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/CreateDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateDelegation(ctx, req.(*CreateDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_DeleteDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).DeleteDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/DeleteDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).DeleteDelegation(ctx, req.(*DeleteDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/ListDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListDelegations(ctx, req.(*ListDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreateGuestBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGuestBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateGuestBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/CreateGuestBooking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateGuestBooking(ctx, req.(*CreateGuestBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_ListVisitors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVisitorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).ListVisitors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/ListVisitors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).ListVisitors(ctx, req.(*ListVisitorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BookingService_CreateTeamBooking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamBookingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BookingServiceServer).CreateTeamBooking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/BookingService.BookingService/CreateTeamBooking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BookingServiceServer).CreateTeamBooking(ctx, req.(*CreateTeamBookingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BookingService_ServiceDesc is the grpc.ServiceDesc for BookingService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BookingService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "BookingService.BookingService",
 	HandlerType: (*BookingServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
@@ -303,6 +1071,10 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateBooking",
 			Handler:    _BookingService_UpdateBooking_Handler,
 		},
+		{
+			MethodName: "ExtendBooking",
+			Handler:    _BookingService_ExtendBooking_Handler,
+		},
 		{
 			MethodName: "CancelBooking",
 			Handler:    _BookingService_CancelBooking_Handler,
@@ -311,10 +1083,102 @@ var BookingService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ApproveByQRBooking",
 			Handler:    _BookingService_ApproveByQRBooking_Handler,
 		},
+		{
+			MethodName: "CheckOutBooking",
+			Handler:    _BookingService_CheckOutBooking_Handler,
+		},
+		{
+			MethodName: "RespondToInvitation",
+			Handler:    _BookingService_RespondToInvitation_Handler,
+		},
+		{
+			MethodName: "MoveBooking",
+			Handler:    _BookingService_MoveBooking_Handler,
+		},
+		{
+			MethodName: "TransferBooking",
+			Handler:    _BookingService_TransferBooking_Handler,
+		},
+		{
+			MethodName: "RespondToTransfer",
+			Handler:    _BookingService_RespondToTransfer_Handler,
+		},
 		{
 			MethodName: "GetSlotsToBooking",
 			Handler:    _BookingService_GetSlotsToBooking_Handler,
 		},
+		{
+			MethodName: "ListResourcePools",
+			Handler:    _BookingService_ListResourcePools_Handler,
+		},
+		{
+			MethodName: "CreateBlackout",
+			Handler:    _BookingService_CreateBlackout_Handler,
+		},
+		{
+			MethodName: "DeleteBlackout",
+			Handler:    _BookingService_DeleteBlackout_Handler,
+		},
+		{
+			MethodName: "GetOpeningHours",
+			Handler:    _BookingService_GetOpeningHours_Handler,
+		},
+		{
+			MethodName: "SetOpeningHours",
+			Handler:    _BookingService_SetOpeningHours_Handler,
+		},
+		{
+			MethodName: "SearchAvailableResources",
+			Handler:    _BookingService_SearchAvailableResources_Handler,
+		},
+		{
+			MethodName: "GetAvailabilityMatrix",
+			Handler:    _BookingService_GetAvailabilityMatrix_Handler,
+		},
+		{
+			MethodName: "CreateRecurringBooking",
+			Handler:    _BookingService_CreateRecurringBooking_Handler,
+		},
+		{
+			MethodName: "CreateBookingBundle",
+			Handler:    _BookingService_CreateBookingBundle_Handler,
+		},
+		{
+			MethodName: "JoinWaitlist",
+			Handler:    _BookingService_JoinWaitlist_Handler,
+		},
+		{
+			MethodName: "LeaveWaitlist",
+			Handler:    _BookingService_LeaveWaitlist_Handler,
+		},
+		{
+			MethodName: "ListWaitlist",
+			Handler:    _BookingService_ListWaitlist_Handler,
+		},
+		{
+			MethodName: "CreateDelegation",
+			Handler:    _BookingService_CreateDelegation_Handler,
+		},
+		{
+			MethodName: "DeleteDelegation",
+			Handler:    _BookingService_DeleteDelegation_Handler,
+		},
+		{
+			MethodName: "ListDelegations",
+			Handler:    _BookingService_ListDelegations_Handler,
+		},
+		{
+			MethodName: "CreateGuestBooking",
+			Handler:    _BookingService_CreateGuestBooking_Handler,
+		},
+		{
+			MethodName: "ListVisitors",
+			Handler:    _BookingService_ListVisitors_Handler,
+		},
+		{
+			MethodName: "CreateTeamBooking",
+			Handler:    _BookingService_CreateTeamBooking_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "protos/booking.proto",
@@ -3,6 +3,7 @@ package booking
 import (
 	"context"
 	"errors"
+	"github.com/pedroxer/booking-service/internal/config"
 	"github.com/pedroxer/booking-service/internal/models"
 	proto_gen "github.com/pedroxer/booking-service/internal/proto_gen/protos"
 	"github.com/pedroxer/booking-service/internal/storage"
@@ -15,17 +16,41 @@ import (
 type BookingGetter interface {
 	GetBookings(ctx context.Context, filters []storage.Field, bookingType string, page int64) ([]models.Booking, int64, error)
 	GetBookingsById(ctx context.Context, bookingType string, bookingId int64) (models.Booking, error)
-	GetTimeSlotsForResource(ctx context.Context, bookingType string, resourceId int64, date time.Time) ([]models.TimeSlot, error)
+	GetTimeSlotsForResource(ctx context.Context, bookingType string, resourceId int64, from, to time.Time) ([]models.TimeSlot, error)
+	GetBookedResourceIds(ctx context.Context, bookingType string) ([]int64, error)
+	GetCurrentBooking(ctx context.Context, bookingType string, resourceId int64, at time.Time) (models.Booking, error)
+	GetResourcesOccupancy(ctx context.Context, bookingType string, resourceIds []int64, from, to time.Time) ([]models.Booking, error)
+	GetPool(ctx context.Context, poolId int64) (models.ResourcePool, error)
+	GetPools(ctx context.Context, zone string) ([]models.ResourcePool, error)
+	GetNextBlockedStart(ctx context.Context, bookingType string, resourceId, excludeBookingId int64, after time.Time) (time.Time, error)
+	GetTransfer(ctx context.Context, transferId int64) (models.BookingTransfer, error)
+	GetGuestBookings(ctx context.Context, bookingType string, from, to time.Time) ([]models.GuestBooking, error)
 }
 
 type BookingCreater interface {
-	CreateBooking(ctx context.Context, bookingType, status string, startTime, endTime time.Time, userId string, resourceId int64) (models.Booking, error)
+	CreateBooking(ctx context.Context, bookingType string, booking models.Booking, check storage.QuotaCheck) (models.Booking, error)
+	CreateBookingSeries(ctx context.Context, bookingType, userId string, resourceId int64, rrule string, occurrences []models.Booking, check storage.QuotaCheck) (models.BookingSeries, error)
+	CreateBookingBundle(ctx context.Context, userId string, items []models.BundleBooking, check storage.QuotaCheck) (int64, []models.BundleBooking, error)
+	CreateGuestBooking(ctx context.Context, bookingType string, booking models.Booking, guest models.Guest, event models.BookingEvent, check storage.QuotaCheck) (models.GuestBooking, error)
+	CreateTeamBooking(ctx context.Context, bookingType string, bookings []models.Booking, check storage.QuotaCheck) ([]models.Booking, error)
 }
 
 type BookingUpdater interface {
-	UpdateBooking(ctx context.Context, bookingID int64, updateFields []storage.Field, bookingType string) (models.Booking, error)
-	DeleteBooking(ctx context.Context, bookingType string, bookingId int64) error
-	ApproveBooking(ctx context.Context, workplaceId int64) (bool, int64, error)
+	UpdateBooking(ctx context.Context, bookingID int64, updateFields []storage.Field, bookingType string, check storage.TransitionCheck) (models.Booking, error)
+	UpdateSeriesBookings(ctx context.Context, bookingType string, seriesId int64, from time.Time, startShift, endShift time.Duration, updateFields []storage.Field, check storage.TransitionCheck, shift storage.ShiftCheck) ([]models.Booking, error)
+	CancelBooking(ctx context.Context, bookingType string, bookingId int64, cancelledBy, reason string, check storage.TransitionCheck) (models.Booking, error)
+	CancelSeriesBookings(ctx context.Context, bookingType string, seriesId int64, from time.Time, statuses []string, cancelledBy, reason string) ([]models.Booking, error)
+	RespondToInvitation(ctx context.Context, bookingId int64, userId, status string) error
+	CancelBundleBookings(ctx context.Context, bundleId int64, statuses []string, cancelledBy, reason string) ([]models.BundleBooking, error)
+	ApproveBooking(ctx context.Context, bookingType string, resourceId int64, userId, licencePlate string, before, after time.Duration, check storage.TransitionCheck) (models.Booking, error)
+	MarkNoShowBookings(ctx context.Context, bookingType string, since, deadline time.Time) ([]models.Booking, error)
+	CheckOutBooking(ctx context.Context, bookingType string, bookingId int64, userId string, check storage.TransitionCheck) (models.CheckOut, error)
+	MoveBooking(ctx context.Context, bookingType string, bookingId int64, ownerId, changedBy string, resourceId int64, buffer models.Buffer, timeZone string) (models.Booking, error)
+	TransferBooking(ctx context.Context, bookingType string, bookingId int64, fromUserId, toUserId, changedBy string, check storage.QuotaCheck) (models.Booking, error)
+	CreateTransferOffer(ctx context.Context, transfer models.BookingTransfer, event models.BookingEvent) (models.BookingTransfer, error)
+	RespondToTransfer(ctx context.Context, transferId int64, userId, changedBy string, accept bool, check storage.TransitionCheck, quota storage.QuotaCheck) (models.BookingTransfer, models.Booking, error)
+	ApproveGuestBooking(ctx context.Context, code string, check storage.GuestCheck) (models.GuestBooking, error)
+	ExpireGuestBookings(ctx context.Context, bookingType string, deadline time.Time) ([]models.Booking, error)
 }
 
 type ClickhouseCreater interface {
@@ -43,10 +68,28 @@ type BookingService struct {
 	bookingUpdater    BookingUpdater
 	bookingCreater    BookingCreater
 	clickhouseCreater ClickhouseCreater
+	waitlist          WaitlistStorage
+	policies          PolicyStorage
+	calendars         CalendarStorage
+	buffers           BufferStorage
+	delegations       DelegationStorage
+	defaultPolicies   map[string]models.Policy
+	defaultBuffers    map[string]models.Buffer
+	checkInBefore     time.Duration
+	checkInAfter      time.Duration
+	noShowSince       time.Time
+	openingHours      openingHours
+	location          *time.Location
+	minSlot           time.Duration
 }
 
-func NewBookingService(logger *log.Logger, click ClickhouseCreater, resourceClient proto_gen.ResourceServiceClient, bookingGetter BookingGetter, creater BookingCreater, updater BookingUpdater) *BookingService {
-
+func NewBookingService(logger *log.Logger, bookingCfg config.Booking, click ClickhouseCreater, resourceClient proto_gen.ResourceServiceClient, bookingGetter BookingGetter, creater BookingCreater, updater BookingUpdater, waitlist WaitlistStorage, policies PolicyStorage, calendars CalendarStorage, buffers BufferStorage, delegations DelegationStorage) *BookingService {
+	location, err := time.LoadLocation(bookingCfg.TimeZone)
+	if err != nil {
+		logger.Warnf("Invalid default time zone %q, using UTC: %s", bookingCfg.TimeZone, err.Error())
+		location = time.UTC
+	}
+	checkInAfter := minutesOr(bookingCfg.CheckInAfterMinutes, defaultCheckInAfter)
 	return &BookingService{
 		logger:            logger,
 		resourceClient:    resourceClient,
@@ -54,10 +97,25 @@ func NewBookingService(logger *log.Logger, click ClickhouseCreater, resourceClie
 		bookingCreater:    creater,
 		bookingUpdater:    updater,
 		clickhouseCreater: click,
+		waitlist:          waitlist,
+		policies:          policies,
+		calendars:         calendars,
+		buffers:           buffers,
+		delegations:       delegations,
+		defaultPolicies:   policiesFromConfig(bookingCfg.Policies),
+		defaultBuffers:    buffersFromConfig(bookingCfg.Buffers),
+		checkInBefore:     minutesOr(bookingCfg.CheckInBeforeMinutes, defaultCheckInBefore),
+		checkInAfter:      checkInAfter,
+		noShowSince:       noShowSince(logger, bookingCfg.NoShowSince, checkInAfter),
+		openingHours:      newOpeningHours(bookingCfg.OpeningHour, bookingCfg.ClosingHour),
+		location:          location,
+		minSlot:           time.Duration(bookingCfg.MinSlotMinutes) * time.Minute,
 	}
 
 }
-func (b BookingService) GetBookings(ctx context.Context, bookingType string, startTime, endTime time.Time, userId string, resourceID, page int64) ([]models.Booking, int64, error) {
+
+// GetBookings returns bookings matching the filters, bookedBy selects the bookings the user made for others.
+func (b BookingService) GetBookings(ctx context.Context, bookingType string, startTime, endTime time.Time, userId, bookedBy string, resourceID, page int64) ([]models.Booking, int64, error) {
 
 	filters := make([]storage.Field, 0)
 	if !startTime.IsZero() {
@@ -72,7 +130,7 @@ func (b BookingService) GetBookings(ctx context.Context, bookingType string, sta
 			Value: endTime,
 		})
 	}
-	if bookingType == utills.WorkplaceType {
+	if bookingType == utills.WorkplaceType || bookingType == utills.RoomType {
 		if resourceID != 0 {
 			filters = append(filters, storage.Field{
 				Name:  "workplace_id",
@@ -93,6 +151,12 @@ func (b BookingService) GetBookings(ctx context.Context, bookingType string, sta
 			Value: userId,
 		})
 	}
+	if bookedBy != "" {
+		filters = append(filters, storage.Field{
+			Name:  "booked_by",
+			Value: bookedBy,
+		})
+	}
 
 	bookings, count, err := b.bookingGetter.GetBookings(ctx, filters, bookingType, page)
 	if err != nil {
@@ -111,88 +175,135 @@ func (b BookingService) GetBookingById(ctx context.Context, bookingType string,
 	return booking, nil
 }
 
-func (b BookingService) CreateBooking(ctx context.Context, bookingType, status string, startTime, endTime time.Time, userId string, resourceId int64) (models.Booking, error) {
-	var (
-		resourceAvailable bool
-		parking           *proto_gen.ParkingSpace
-		err               error
-	)
-	if bookingType == utills.WorkplaceType {
-		workplace, err := b.resourceClient.GetWorkplaceById(ctx, &proto_gen.GetWorkplaceByIdRequest{
-			Id: resourceId,
-		})
-		if err != nil {
-			b.logger.Warn("Error getting resource ", err)
-			return models.Booking{}, err
-		}
-		resourceAvailable = workplace.IsAvailable
-	} else if bookingType == utills.ParkingType {
-		parking, err = b.resourceClient.GetParkingSpaceById(ctx, &proto_gen.GetParkingSpaceByIdRequest{
-			Id: resourceId,
-		})
-		if err != nil {
-			b.logger.Warn("Error getting resource ", err)
-			return models.Booking{}, err
-		}
-		resourceAvailable = parking.IsAvailable
+func (b BookingService) CreateBooking(ctx context.Context, bookingType string, newBooking models.Booking) (models.Booking, error) {
+	newBooking, err := b.prepareBooking(ctx, bookingType, newBooking)
+	if err != nil {
+		return models.Booking{}, err
 	}
+	return b.createBooking(ctx, bookingType, newBooking)
+}
 
-	if !resourceAvailable {
+// prepareBooking validates a new booking against the resource, its calendar and the policies,
+// and fills in what the service derives: the time zone, the buffers and the attendees.
+func (b BookingService) prepareBooking(ctx context.Context, bookingType string, newBooking models.Booking) (models.Booking, error) {
+	newBooking, err := b.checkBookedBy(ctx, bookingType, newBooking)
+	if err != nil {
+		return models.Booking{}, err
+	}
+	resource, err := b.getResource(ctx, bookingType, newBooking.ResourceId)
+	if err != nil {
+		b.logger.Warn("Error getting resource ", err)
+		return models.Booking{}, err
+	}
+	if !resource.IsAvailable {
 		b.logger.Warn("Resource is not available")
-		return models.Booking{}, errors.New("resource is not available")
+		return models.Booking{}, utills.ErrResourceUnavailable
 	}
+	return b.prepareFor(ctx, bookingType, resource, newBooking)
+}
 
-	booking, err := b.bookingCreater.CreateBooking(ctx, bookingType, status, startTime, endTime, userId, resourceId)
+// prepareFor is prepareBooking for a resource that is already fetched and available, series
+// run every occurrence through it.
+func (b BookingService) prepareFor(ctx context.Context, bookingType string, resource models.Resource, newBooking models.Booking) (models.Booking, error) {
+	if err := b.checkOpen(ctx, bookingType, resource, newBooking.StartTime, newBooking.EndTime); err != nil {
+		b.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	location, err := b.zoneLocation(ctx, resource.Zone)
 	if err != nil {
-		b.logger.Warnf("Error creating booking: %s", err.Error())
 		return models.Booking{}, err
 	}
-	if bookingType == utills.WorkplaceType {
-		_, err = b.resourceClient.UpdateWorkplace(ctx, &proto_gen.UpdateWorkplaceRequest{
-			Id:          resourceId,
-			IsAvailable: false,
-		})
-	} else if bookingType == utills.ParkingType {
-		err = b.clickhouseCreater.AddToClickHouse(ctx,
-			booking.BookingId,
-			parking.Id,
-			booking.UserId,
-			utills.ParkingType,
-			utills.StatusConfirmed,
-			parking.Address,
-			parking.Zone,
-			-1,
-			parking.Number,
-			time.Now(),
-			time.Now(),
-			booking.StartTime,
-			booking.EndTime,
-			int64(math.Round(booking.EndTime.Sub(booking.StartTime).Minutes())))
+	newBooking.TimeZone = location.String()
+	buffer, err := b.bufferFor(ctx, bookingType, resource.Id)
+	if err != nil {
+		return models.Booking{}, err
+	}
+	newBooking = withBuffer(newBooking, buffer)
+	newBooking.LicencePlate = bookingLicencePlate(bookingType, newBooking.LicencePlate)
+	if bookingType == utills.RoomType {
+		newBooking.Attendees, err = roomAttendees(resource, newBooking.UserId, newBooking.Attendees)
 		if err != nil {
-			b.logger.Warnf("Error adding to clickhouse: %s", err.Error())
+			b.logger.Warn(err)
+			return models.Booking{}, err
 		}
-		_, err = b.resourceClient.UpdateParkingSpace(ctx, &proto_gen.UpdateParkingSpaceRequest{
-			Id:          resourceId,
-			IsAvailable: false,
-		})
-
+	} else {
+		newBooking.Attendees = nil
+	}
+	if err := b.checkPolicy(ctx, bookingType, resource.Zone, newBooking, nil); err != nil {
+		b.logger.Warn(err)
+		return models.Booking{}, err
 	}
+	return newBooking, nil
+}
+
+// createBooking only stores the booking, it gets into ClickHouse when it is checked in.
+func (b BookingService) createBooking(ctx context.Context, bookingType string, newBooking models.Booking) (models.Booking, error) {
+	booking, err := b.bookingCreater.CreateBooking(ctx, bookingType, newBooking, b.checkQuota)
 	if err != nil {
-		b.logger.Warnf("Error updating resource: %s", err.Error())
+		b.logger.Warnf("Error creating booking: %s", err.Error())
 		return models.Booking{}, err
 	}
 	return booking, nil
 }
 
-func (b BookingService) UpdateBooking(ctx context.Context, bookingType, status string, bookingID int64, startTime, endTime time.Time) (models.Booking, error) {
+// UpdateBooking changes the times or the status of a booking, userId is its owner or a delegate of
+// the owner.
+func (b BookingService) UpdateBooking(ctx context.Context, bookingType, status string, bookingID int64, startTime, endTime time.Time, scope, userId string) (models.Booking, error) {
+	if err := checkStatusUpdate(status); err != nil {
+		b.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	current, err := b.bookingGetter.GetBookingsById(ctx, bookingType, bookingID)
+	if err != nil {
+		b.logger.Warnf("Error getting booking: %s", err.Error())
+		return models.Booking{}, err
+	}
+	if err := b.checkOwner(ctx, bookingType, userId, current.UserId); err != nil {
+		b.logger.Warn(err)
+		return models.Booking{}, err
+	}
+
+	timesChanged := !startTime.IsZero() || !endTime.IsZero()
+	var updated models.Booking
+	var resource models.Resource
+	if timesChanged {
+		updated = current
+		if !startTime.IsZero() {
+			updated.StartTime = startTime
+		}
+		if !endTime.IsZero() {
+			updated.EndTime = endTime
+		}
+		if !updated.EndTime.After(updated.StartTime) {
+			b.logger.Warn(utills.ErrInvalidTimeRange)
+			return models.Booking{}, utills.ErrInvalidTimeRange
+		}
+		resource, err = b.getResource(ctx, bookingType, current.ResourceId)
+		if err != nil {
+			b.logger.Warn("Error getting resource ", err)
+			return models.Booking{}, err
+		}
+		if err := b.checkOpen(ctx, bookingType, resource, updated.StartTime, updated.EndTime); err != nil {
+			b.logger.Warn(err)
+			return models.Booking{}, err
+		}
+		if err := b.checkPolicy(ctx, bookingType, resource.Zone, updated, &current); err != nil {
+			b.logger.Warn(err)
+			return models.Booking{}, err
+		}
+	}
+	if current.SeriesId != 0 && (scope == utills.ScopeThisAndFollowing || scope == utills.ScopeWholeSeries) {
+		return b.updateSeries(ctx, bookingType, status, current, resource, startTime, endTime, scope)
+	}
+
 	updateFields := make([]storage.Field, 0)
-	if startTime != time.Unix(0, 0) {
+	if !startTime.IsZero() {
 		updateFields = append(updateFields, storage.Field{
 			Name:  "start_date",
 			Value: startTime,
 		})
 	}
-	if endTime != time.Unix(0, 0) {
+	if !endTime.IsZero() {
 		updateFields = append(updateFields, storage.Field{
 			Name:  "end_date",
 			Value: endTime,
@@ -204,72 +315,101 @@ func (b BookingService) UpdateBooking(ctx context.Context, bookingType, status s
 			Value: status,
 		})
 	}
-	booking, err := b.bookingUpdater.UpdateBooking(ctx, bookingID, updateFields, bookingType)
+	booking, err := b.bookingUpdater.UpdateBooking(ctx, bookingID, updateFields, bookingType, checkTransition)
 	if err != nil {
 		b.logger.Warnf("Error updating booking: %s", err.Error())
+		if timesChanged && errors.Is(err, utills.ErrBookingConflict) && isExtension(current, updated) {
+			return models.Booking{}, b.extensionConflict(ctx, bookingType, resource, current, updated.EndTime, err)
+		}
 		return models.Booking{}, err
 	}
 	return booking, nil
 }
 
-func (b BookingService) CancelBooking(ctx context.Context, bookingType string, bookingId int64) (bool, error) {
+func (b BookingService) CancelBooking(ctx context.Context, bookingType string, bookingId int64, scope, userId, reason string) (bool, error) {
 	booking, err := b.bookingGetter.GetBookingsById(ctx, bookingType, bookingId)
 	if err != nil {
 		b.logger.Warnf("Error getting booking: %s", err.Error())
 		return false, err
 	}
-	if bookingType == utills.WorkplaceType {
-		_, err = b.resourceClient.UpdateWorkplace(ctx, &proto_gen.UpdateWorkplaceRequest{
-			Id:          booking.ResourceId,
-			IsAvailable: true,
-		})
-	} else if bookingType == utills.ParkingType {
-		_, err = b.resourceClient.UpdateParkingSpace(ctx, &proto_gen.UpdateParkingSpaceRequest{
-			Id:          booking.ResourceId,
-			IsAvailable: true,
-		})
-	}
-	if err != nil {
-		b.logger.Warnf("Error updating resource: %s", err.Error())
+	if err := b.checkOwner(ctx, bookingType, userId, booking.UserId); err != nil {
+		b.logger.Warn(err)
 		return false, err
 	}
 
-	err = b.bookingUpdater.DeleteBooking(ctx, bookingType, bookingId)
+	if booking.SeriesId != 0 && (scope == utills.ScopeThisAndFollowing || scope == utills.ScopeWholeSeries) {
+		return b.cancelSeries(ctx, bookingType, booking, scope, userId, reason)
+	}
+	if booking.BundleId != 0 && scope == utills.ScopeWholeBundle {
+		return b.cancelBundle(ctx, booking.BundleId, userId, reason)
+	}
+
+	cancelled, err := b.bookingUpdater.CancelBooking(ctx, bookingType, bookingId, userId, reason, checkTransition)
 	if err != nil {
-		b.logger.Warnf("Error deleting booking: %s", err.Error())
+		b.logger.Warnf("Error cancelling booking: %s", err.Error())
 		return false, err
 	}
+	b.bookingCancelled(ctx, bookingType, cancelled)
 	return true, nil
 
 }
 
-func (b BookingService) ApproveBooking(ctx context.Context, uniqueTag string) (bool, error) {
-	workplace, err := b.resourceClient.GetWorkplaceByUniqueTag(ctx, &proto_gen.GetWorkplaceByUniqueTagRequest{UniqueTag: uniqueTag})
+// bookingCancelled reports the cancellation to ClickHouse and offers the freed slot to the waitlist.
+func (b BookingService) bookingCancelled(ctx context.Context, bookingType string, booking models.Booking) {
+	resource, err := b.getResource(ctx, bookingType, booking.ResourceId)
 	if err != nil {
 		b.logger.Warnf("Error getting resource: %s", err.Error())
-		return false, err
+		return
+	}
+	now := time.Now()
+	err = b.clickhouseCreater.AddToClickHouse(ctx,
+		booking.BookingId,
+		resource.Id,
+		booking.UserId,
+		bookingType,
+		utills.ClickStatusCanceled,
+		resource.Address,
+		resource.Zone,
+		resource.Floor,
+		resource.Number,
+		now,
+		now,
+		booking.StartTime,
+		booking.EndTime,
+		0)
+	if err != nil {
+		b.logger.Warnf("Error adding to clickhouse: %s", err.Error())
 	}
+	b.promoteWaitlist(ctx, bookingType, booking.ResourceId, booking.StartTime, booking.EndTime)
+}
 
-	success, bookingId, err := b.bookingUpdater.ApproveBooking(ctx, workplace.Id)
+// ApproveBooking checks in the current booking of the resource with the scanned unique tag.
+// For parking the licence plate read at the gate can be used instead of the user id.
+func (b BookingService) ApproveBooking(ctx context.Context, bookingType, uniqueTag, userId, licencePlate string) (models.Booking, error) {
+	resource, err := b.getResourceByTag(ctx, bookingType, uniqueTag)
 	if err != nil {
-		b.logger.Warnf("Error approving booking: %s", err.Error())
-		return false, err
+		b.logger.Warnf("Error getting resource: %s", err.Error())
+		return models.Booking{}, err
+	}
+
+	if bookingType != utills.ParkingType {
+		licencePlate = ""
 	}
-	booking, err := b.bookingGetter.GetBookingsById(ctx, utills.WorkplaceType, bookingId)
+	booking, err := b.bookingUpdater.ApproveBooking(ctx, bookingType, resource.Id, userId, normalizeLicencePlate(licencePlate), b.checkInBefore, b.checkInAfter, checkTransition)
 	if err != nil {
-		b.logger.Warnf("Error getting booking: %s", err.Error())
-		return false, err
+		b.logger.Warnf("Error approving booking: %s", err.Error())
+		return models.Booking{}, err
 	}
 	err = b.clickhouseCreater.AddToClickHouse(ctx,
 		booking.BookingId,
-		workplace.Id,
+		resource.Id,
 		booking.UserId,
-		utills.WorkplaceType,
+		bookingType,
 		utills.StatusConfirmed,
-		workplace.Address,
-		workplace.Zone,
-		workplace.Floor,
-		workplace.Number,
+		resource.Address,
+		resource.Zone,
+		resource.Floor,
+		resource.Number,
 		time.Now(),
 		time.Now(),
 		booking.StartTime,
@@ -279,26 +419,50 @@ func (b BookingService) ApproveBooking(ctx context.Context, uniqueTag string) (b
 	if err != nil {
 		b.logger.Warnf("Error adding to clickhouse: %s", err.Error())
 	}
-	return success, nil
+	return booking, nil
 }
 
-func (b BookingService) GetTimeSlotsForBooking(ctx context.Context, bookingType string, resourceId int64, date time.Time) ([]models.TimeSlot, error) {
-	timeSlots, err := b.bookingGetter.GetTimeSlotsForResource(ctx, bookingType, resourceId, date)
+// GetTimeSlotsForBooking returns the whole day of date split into busy and free slots. The day
+// is taken in the time zone of the resource office, which is returned along with the slots.
+func (b BookingService) GetTimeSlotsForBooking(ctx context.Context, bookingType string, resourceId int64, date time.Time) ([]models.TimeSlot, string, error) {
+	resource, err := b.getResource(ctx, bookingType, resourceId)
+	if err != nil {
+		b.logger.Warn("Error getting resource ", err)
+		return nil, "", err
+	}
+	location, err := b.zoneLocation(ctx, resource.Zone)
+	if err != nil {
+		return nil, "", err
+	}
+	dayStart := startOfDay(date.In(location))
+	dayEnd := dayStart.AddDate(0, 0, 1)
+
+	bookings, err := b.bookingGetter.GetTimeSlotsForResource(ctx, bookingType, resourceId, dayStart, dayEnd)
 	if err != nil {
 		b.logger.Warnf("Error getting free slots: %s", err.Error())
-		return nil, err
+		return nil, "", err
 	}
-	resultTimeSlots := make([]models.TimeSlot, 0)
-	for i := 0; i < len(timeSlots)-1; i++ {
-		var timeSlot models.TimeSlot
-		diff := timeSlots[i].EndTime.Sub(timeSlots[i+1].StartTime)
-		if diff.Hours() > 0 {
-			timeSlot.StartTime = timeSlots[i].EndTime
-			timeSlot.EndTime = timeSlots[i+1].StartTime
-			timeSlot.Busy = false
-		}
-		resultTimeSlots = append(resultTimeSlots, timeSlot)
+	buffer, err := b.bufferFor(ctx, bookingType, resourceId)
+	if err != nil {
+		return nil, "", err
+	}
+	bookings = unbookableRanges(bookings, buffer)
+	cal, err := b.calendarFor(ctx, bookingType, resource, dayStart, dayEnd)
+	if err != nil {
+		return nil, "", err
+	}
+	closed := cal.closedPeriods(dayStart, dayEnd)
+	if bookingType == utills.PoolType {
+		return buildPoolSlots(dayStart, dayEnd, closed, bookings, resource.Capacity, b.minSlot), location.String(), nil
+	}
+	return buildSlots(dayStart, dayEnd, closed, bookings, b.minSlot), location.String(), nil
+}
+
+func (b BookingService) GetResourcePools(ctx context.Context, zone string) ([]models.ResourcePool, error) {
+	pools, err := b.bookingGetter.GetPools(ctx, zone)
+	if err != nil {
+		b.logger.Warnf("Error getting pools: %s", err.Error())
+		return nil, err
 	}
-	timeSlots = append(timeSlots, resultTimeSlots...)
-	return timeSlots, nil
+	return pools, nil
 }
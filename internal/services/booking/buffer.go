@@ -0,0 +1,87 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"github.com/pedroxer/booking-service/internal/config"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"time"
+)
+
+type BufferStorage interface {
+	GetResourceBuffer(ctx context.Context, bookingType string, resourceId int64) (models.Buffer, error)
+	GetResourceBuffers(ctx context.Context, bookingType string, resourceIds []int64) (map[int64]models.Buffer, error)
+}
+
+func buffersFromConfig(buffers map[string]config.Buffer) map[string]models.Buffer {
+	converted := make(map[string]models.Buffer, len(buffers))
+	for bookingType, buffer := range buffers {
+		converted[bookingType] = models.Buffer{
+			Before: time.Duration(buffer.BeforeMinutes) * time.Minute,
+			After:  time.Duration(buffer.AfterMinutes) * time.Minute,
+		}
+	}
+	return converted
+}
+
+// bufferFor returns the buffers of the resource, its own override wins over the booking type default.
+func (b BookingService) bufferFor(ctx context.Context, bookingType string, resourceId int64) (models.Buffer, error) {
+	buffer, err := b.buffers.GetResourceBuffer(ctx, bookingType, resourceId)
+	if errors.Is(err, utills.ErrNoRows) {
+		return b.defaultBuffers[bookingType], nil
+	}
+	if err != nil {
+		b.logger.Warnf("Error getting resource buffer: %s", err.Error())
+		return models.Buffer{}, err
+	}
+	return buffer, nil
+}
+
+// buffersFor returns the buffers of every resource, like bufferFor does for one.
+func (b BookingService) buffersFor(ctx context.Context, bookingType string, resourceIds []int64) (map[int64]models.Buffer, error) {
+	own, err := b.buffers.GetResourceBuffers(ctx, bookingType, resourceIds)
+	if err != nil {
+		b.logger.Warnf("Error getting resource buffers: %s", err.Error())
+		return nil, err
+	}
+	buffers := make(map[int64]models.Buffer, len(resourceIds))
+	for _, id := range resourceIds {
+		buffer, ok := own[id]
+		if !ok {
+			buffer = b.defaultBuffers[bookingType]
+		}
+		buffers[id] = buffer
+	}
+	return buffers, nil
+}
+
+// widestBuffer takes the longest before and after buffers of all resources.
+func widestBuffer(buffers map[int64]models.Buffer) models.Buffer {
+	var widest models.Buffer
+	for _, buffer := range buffers {
+		widest.Before = max(widest.Before, buffer.Before)
+		widest.After = max(widest.After, buffer.After)
+	}
+	return widest
+}
+
+// withBuffer sets the range the booking blocks its resource for.
+func withBuffer(booking models.Booking, buffer models.Buffer) models.Booking {
+	booking.BlockedStart = booking.StartTime.Add(-buffer.Before)
+	booking.BlockedEnd = booking.EndTime.Add(buffer.After)
+	return booking
+}
+
+// unbookableRanges turns blocked ranges of existing bookings into the ranges a new booking of the
+// same resource cannot overlap: its own buffers must not touch them either, so a range is
+// widened by the after buffer at the start and by the before buffer at the end.
+func unbookableRanges(blocked []models.TimeSlot, buffer models.Buffer) []models.TimeSlot {
+	widened := make([]models.TimeSlot, len(blocked))
+	for i, slot := range blocked {
+		slot.StartTime = slot.StartTime.Add(-buffer.After)
+		slot.EndTime = slot.EndTime.Add(buffer.Before)
+		widened[i] = slot
+	}
+	return widened
+}
@@ -0,0 +1,42 @@
+package booking
+
+import (
+	"context"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+)
+
+// CreateBookingBundle books several resources for the user at once, either all of them or none.
+// Every item is validated like a single booking, bookedBy books them for the user with a delegation.
+func (b BookingService) CreateBookingBundle(ctx context.Context, userId, bookedBy string, items []models.BundleBooking) (int64, []models.BundleBooking, error) {
+	for i, item := range items {
+		item.Booking.UserId = userId
+		item.Booking.BookedBy = bookedBy
+		item.Booking.Status = utills.StatusPending
+		booking, err := b.prepareBooking(ctx, item.BookingType, item.Booking)
+		if err != nil {
+			return 0, nil, err
+		}
+		items[i].Booking = booking
+	}
+
+	bundleId, bookings, err := b.bookingCreater.CreateBookingBundle(ctx, userId, items, b.checkQuota)
+	if err != nil {
+		b.logger.Warnf("Error creating booking bundle: %s", err.Error())
+		return 0, nil, err
+	}
+	return bundleId, bookings, nil
+}
+
+func (b BookingService) cancelBundle(ctx context.Context, bundleId int64, userId, reason string) (bool, error) {
+	cancelled, err := b.bookingUpdater.CancelBundleBookings(ctx, bundleId, statusesAllowing(utills.StatusCancelled), userId, reason)
+	if err != nil {
+		b.logger.Warnf("Error cancelling booking bundle: %s", err.Error())
+		return false, err
+	}
+	b.logger.Infof("cancelled %d bookings of bundle %d", len(cancelled), bundleId)
+	for _, item := range cancelled {
+		b.bookingCancelled(ctx, item.BookingType, item.Booking)
+	}
+	return true, nil
+}
@@ -0,0 +1,278 @@
+package booking
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	_ "time/tzdata" // в alpine-образе нет базы часовых поясов
+)
+
+type CalendarStorage interface {
+	GetOpeningHours(ctx context.Context, zone string) ([]models.OpeningHours, error)
+	SetOpeningHours(ctx context.Context, zone string, hours []models.OpeningHours) error
+	GetHolidays(ctx context.Context, zone string, from, to time.Time) ([]models.Holiday, error)
+	CreateHolidays(ctx context.Context, zone string, holidays []models.Holiday) (int64, error)
+	GetBlackouts(ctx context.Context, bookingType string, resourceId int64, from, to time.Time) ([]models.Blackout, error)
+	CreateBlackout(ctx context.Context, blackout models.Blackout) (models.Blackout, error)
+	DeleteBlackout(ctx context.Context, blackoutId int64) error
+	GetZoneTimeZone(ctx context.Context, zone string) (string, error)
+}
+
+// zoneLocation returns the time zone of the office zone, days of the zone start at its midnight.
+func (b BookingService) zoneLocation(ctx context.Context, zone string) (*time.Location, error) {
+	name, err := b.calendars.GetZoneTimeZone(ctx, zone)
+	if errors.Is(err, utills.ErrNoRows) {
+		return b.location, nil
+	}
+	if err != nil {
+		b.logger.Warnf("Error getting zone time zone: %s", err.Error())
+		return nil, err
+	}
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		b.logger.Warnf("Invalid time zone %q of zone %s: %s", name, zone, err.Error())
+		return nil, err
+	}
+	return location, nil
+}
+
+// calendar tells when a resource is closed. weekly is nil when the zone has no calendar of its
+// own and every day is open for defaults, otherwise days missing from weekly are closed.
+// Days start at midnight in location, or in the location of the range when it is nil.
+type calendar struct {
+	location  *time.Location
+	weekly    map[time.Weekday]openingHours
+	defaults  openingHours
+	holidays  []models.Holiday
+	blackouts []models.TimeSlot
+}
+
+// closedPeriods returns the sorted, merged periods within [from, to) when the resource is closed.
+func (c calendar) closedPeriods(from, to time.Time) []models.TimeSlot {
+	if c.location != nil {
+		from = from.In(c.location)
+	}
+	closed := make([]models.TimeSlot, 0)
+	for day := startOfDay(from); day.Before(to); day = day.AddDate(0, 0, 1) {
+		next := day.AddDate(0, 0, 1)
+		if c.isHoliday(day) {
+			closed = append(closed, models.TimeSlot{StartTime: day, EndTime: next})
+			continue
+		}
+		hours := c.defaults
+		if c.weekly != nil {
+			weekday, ok := c.weekly[day.Weekday()]
+			if !ok {
+				closed = append(closed, models.TimeSlot{StartTime: day, EndTime: next})
+				continue
+			}
+			hours = weekday
+		}
+		closed = append(closed,
+			models.TimeSlot{StartTime: day, EndTime: day.Add(hours.opensAt)},
+			models.TimeSlot{StartTime: day.Add(hours.closesAt), EndTime: next})
+	}
+	closed = append(closed, c.blackouts...)
+	return mergeSlots(clipSlots(closed, from, to))
+}
+
+func (c calendar) isHoliday(day time.Time) bool {
+	year, month, date := day.Date()
+	for _, holiday := range c.holidays {
+		holidayYear, holidayMonth, holidayDate := holiday.Date.Date()
+		if year == holidayYear && month == holidayMonth && date == holidayDate {
+			return true
+		}
+	}
+	return false
+}
+
+// calendarFor loads the calendar of the resource zone with holidays and blackouts between from and to.
+func (b BookingService) calendarFor(ctx context.Context, bookingType string, resource models.Resource, from, to time.Time) (calendar, error) {
+	location, err := b.zoneLocation(ctx, resource.Zone)
+	if err != nil {
+		return calendar{}, err
+	}
+	cal := calendar{location: location, defaults: b.openingHours}
+	hours, err := b.calendars.GetOpeningHours(ctx, resource.Zone)
+	if err != nil {
+		b.logger.Warnf("Error getting opening hours: %s", err.Error())
+		return calendar{}, err
+	}
+	if len(hours) > 0 {
+		cal.weekly = make(map[time.Weekday]openingHours, len(hours))
+		for _, day := range hours {
+			cal.weekly[day.Weekday] = openingHours{opensAt: day.OpensAt, closesAt: day.ClosesAt}
+		}
+	}
+	cal.holidays, err = b.calendars.GetHolidays(ctx, resource.Zone, startOfDay(from.In(location)), to.In(location))
+	if err != nil {
+		b.logger.Warnf("Error getting holidays: %s", err.Error())
+		return calendar{}, err
+	}
+	blackouts, err := b.calendars.GetBlackouts(ctx, bookingType, resource.Id, from, to)
+	if err != nil {
+		b.logger.Warnf("Error getting blackouts: %s", err.Error())
+		return calendar{}, err
+	}
+	for _, blackout := range blackouts {
+		cal.blackouts = append(cal.blackouts, models.TimeSlot{StartTime: blackout.StartTime, EndTime: blackout.EndTime})
+	}
+	return cal, nil
+}
+
+// checkOpen fails when any part of [start, end) falls into a closed period of the resource.
+func (b BookingService) checkOpen(ctx context.Context, bookingType string, resource models.Resource, start, end time.Time) error {
+	cal, err := b.calendarFor(ctx, bookingType, resource, start, end)
+	if err != nil {
+		return err
+	}
+	if closed := cal.closedPeriods(start, end); len(closed) > 0 {
+		return fmt.Errorf("%w: %s - %s", utills.ErrResourceClosed, closed[0].StartTime.Format(time.RFC3339), closed[0].EndTime.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func (b BookingService) GetOpeningHours(ctx context.Context, zone string) ([]models.OpeningHours, error) {
+	hours, err := b.calendars.GetOpeningHours(ctx, zone)
+	if err != nil {
+		b.logger.Warnf("Error getting opening hours: %s", err.Error())
+		return nil, err
+	}
+	sort.Slice(hours, func(i, j int) bool {
+		return hours[i].Weekday < hours[j].Weekday
+	})
+	return hours, nil
+}
+
+// SetOpeningHours replaces the calendar of the zone. Existing bookings are kept even when they
+// fall outside the new hours, the hours only apply to new bookings.
+func (b BookingService) SetOpeningHours(ctx context.Context, zone string, hours []models.OpeningHours) ([]models.OpeningHours, error) {
+	if err := b.calendars.SetOpeningHours(ctx, zone, hours); err != nil {
+		b.logger.Warnf("Error setting opening hours: %s", err.Error())
+		return nil, err
+	}
+	return b.GetOpeningHours(ctx, zone)
+}
+
+func (b BookingService) CreateBlackout(ctx context.Context, blackout models.Blackout) (models.Blackout, error) {
+	if _, err := b.getResource(ctx, blackout.BookingType, blackout.ResourceId); err != nil {
+		b.logger.Warn("Error getting resource ", err)
+		return models.Blackout{}, err
+	}
+	created, err := b.calendars.CreateBlackout(ctx, blackout)
+	if err != nil {
+		b.logger.Warnf("Error creating blackout: %s", err.Error())
+		return models.Blackout{}, err
+	}
+	return created, nil
+}
+
+func (b BookingService) DeleteBlackout(ctx context.Context, blackoutId int64) (bool, error) {
+	if err := b.calendars.DeleteBlackout(ctx, blackoutId); err != nil {
+		b.logger.Warnf("Error deleting blackout: %s", err.Error())
+		return false, err
+	}
+	return true, nil
+}
+
+// ImportHolidays adds the all-day events of an iCalendar file as holidays of the zone,
+// or of all zones when zone is empty. It returns the number of added days.
+func (b BookingService) ImportHolidays(ctx context.Context, zone string, ics io.Reader) (int64, error) {
+	holidays, err := parseICSHolidays(ics)
+	if err != nil {
+		b.logger.Warnf("Error parsing holidays: %s", err.Error())
+		return 0, err
+	}
+	added, err := b.calendars.CreateHolidays(ctx, zone, holidays)
+	if err != nil {
+		b.logger.Warnf("Error creating holidays: %s", err.Error())
+		return 0, err
+	}
+	return added, nil
+}
+
+// parseICSHolidays reads VEVENTs of an iCalendar file. An event makes a holiday of every day
+// from DTSTART up to the exclusive DTEND, recurrence rules are not expanded.
+func parseICSHolidays(r io.Reader) ([]models.Holiday, error) {
+	lines, err := unfoldICSLines(r)
+	if err != nil {
+		return nil, err
+	}
+	holidays := make([]models.Holiday, 0)
+	var inEvent bool
+	var name string
+	var start, end time.Time
+	for _, line := range lines {
+		property, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		property, _, _ = strings.Cut(property, ";")
+		switch strings.ToUpper(property) {
+		case "BEGIN":
+			if strings.EqualFold(value, "VEVENT") {
+				inEvent, name, start, end = true, "", time.Time{}, time.Time{}
+			}
+		case "SUMMARY":
+			name = unescapeICSText(value)
+		case "DTSTART", "DTEND":
+			if !inEvent {
+				continue
+			}
+			if len(value) < 8 {
+				return nil, fmt.Errorf("invalid %s %q", property, value)
+			}
+			date, err := time.Parse("20060102", value[:8])
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s %q: %w", property, value, err)
+			}
+			if strings.EqualFold(property, "DTSTART") {
+				start = date
+			} else {
+				end = date
+			}
+		case "END":
+			if !inEvent || !strings.EqualFold(value, "VEVENT") {
+				continue
+			}
+			inEvent = false
+			if start.IsZero() {
+				return nil, fmt.Errorf("event %q has no DTSTART", name)
+			}
+			if !end.After(start) {
+				end = start.AddDate(0, 0, 1)
+			}
+			for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+				holidays = append(holidays, models.Holiday{Date: day, Name: name})
+			}
+		}
+	}
+	return holidays, nil
+}
+
+// unfoldICSLines joins continuation lines, which start with a space or a tab (RFC 5545, 3.1).
+func unfoldICSLines(r io.Reader) ([]string, error) {
+	lines := make([]string, 0)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return lines, scanner.Err()
+}
+
+func unescapeICSText(text string) string {
+	return strings.NewReplacer(`\n`, " ", `\N`, " ", `\,`, ",", `\;`, ";", `\\`, `\`).Replace(text)
+}
@@ -0,0 +1,119 @@
+package booking
+
+import (
+	"github.com/pedroxer/booking-service/internal/models"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClosedPeriods(t *testing.T) {
+	// 2024-03-11 is a Monday.
+	monday := time.Date(2024, time.March, 11, 0, 0, 0, 0, time.UTC)
+	at := func(day, hour int) time.Time {
+		return monday.AddDate(0, 0, day).Add(time.Duration(hour) * time.Hour)
+	}
+	period := func(from, to time.Time) models.TimeSlot {
+		return models.TimeSlot{StartTime: from, EndTime: to, Busy: true}
+	}
+	weekdays := map[time.Weekday]openingHours{}
+	for day := time.Monday; day <= time.Friday; day++ {
+		weekdays[day] = newOpeningHours(9, 18)
+	}
+
+	tests := []struct {
+		name     string
+		calendar calendar
+		from, to time.Time
+		want     []models.TimeSlot
+	}{
+		{
+			name:     "default hours every day",
+			calendar: calendar{defaults: newOpeningHours(8, 21)},
+			from:     at(5, 0),
+			to:       at(7, 0),
+			want:     []models.TimeSlot{period(at(5, 0), at(5, 8)), period(at(5, 21), at(6, 8)), period(at(6, 21), at(7, 0))},
+		},
+		{
+			name:     "weekend is closed by the zone calendar",
+			calendar: calendar{defaults: newOpeningHours(8, 21), weekly: weekdays},
+			from:     at(4, 12),
+			to:       at(7, 12),
+			want:     []models.TimeSlot{period(at(4, 18), at(7, 9))},
+		},
+		{
+			name:     "holiday closes the whole day",
+			calendar: calendar{defaults: newOpeningHours(0, 0), holidays: []models.Holiday{{Date: at(1, 0), Name: "day off"}}},
+			from:     at(0, 0),
+			to:       at(3, 0),
+			want:     []models.TimeSlot{period(at(1, 0), at(2, 0))},
+		},
+		{
+			name:     "blackout inside opening hours",
+			calendar: calendar{defaults: newOpeningHours(9, 18), blackouts: []models.TimeSlot{{StartTime: at(0, 12), EndTime: at(0, 14)}}},
+			from:     at(0, 10),
+			to:       at(0, 16),
+			want:     []models.TimeSlot{period(at(0, 12), at(0, 14))},
+		},
+		{
+			name:     "open range",
+			calendar: calendar{defaults: newOpeningHours(9, 18)},
+			from:     at(0, 10),
+			to:       at(0, 16),
+			want:     []models.TimeSlot{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.calendar.closedPeriods(tt.from, tt.to)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d periods %v, want %d %v", len(got), got, len(tt.want), tt.want)
+			}
+			for i := range got {
+				if !got[i].StartTime.Equal(tt.want[i].StartTime) || !got[i].EndTime.Equal(tt.want[i].EndTime) {
+					t.Errorf("period %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseICSHolidays(t *testing.T) {
+	ics := strings.Join([]string{
+		"BEGIN:VCALENDAR",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20250101",
+		"DTEND;VALUE=DATE:20250103",
+		"SUMMARY:New Year\\, holidays",
+		"END:VEVENT",
+		"BEGIN:VEVENT",
+		"DTSTART;VALUE=DATE:20250308",
+		"SUMMARY:Women's",
+		"  Day",
+		"END:VEVENT",
+		"END:VCALENDAR",
+	}, "\r\n")
+
+	got, err := parseICSHolidays(strings.NewReader(ics))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []models.Holiday{
+		{Date: time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), Name: "New Year, holidays"},
+		{Date: time.Date(2025, time.January, 2, 0, 0, 0, 0, time.UTC), Name: "New Year, holidays"},
+		{Date: time.Date(2025, time.March, 8, 0, 0, 0, 0, time.UTC), Name: "Women's Day"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range got {
+		if !got[i].Date.Equal(want[i].Date) || got[i].Name != want[i].Name {
+			t.Errorf("holiday %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	if _, err := parseICSHolidays(strings.NewReader("BEGIN:VEVENT\nDTSTART:2025\nEND:VEVENT")); err == nil {
+		t.Error("expected an error for invalid DTSTART")
+	}
+}
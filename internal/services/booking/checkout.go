@@ -0,0 +1,67 @@
+package booking
+
+import (
+	"context"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"math"
+	"time"
+)
+
+// CheckOutBooking releases the booking before its end. The booking is given either by id or by
+// the unique tag of the resource, then the booking that occupies the resource now is taken.
+func (b BookingService) CheckOutBooking(ctx context.Context, bookingType string, bookingId int64, uniqueTag, userId string) (models.Booking, error) {
+	var resource models.Resource
+	var err error
+	if uniqueTag != "" {
+		resource, err = b.getResourceByTag(ctx, bookingType, uniqueTag)
+		if err != nil {
+			b.logger.Warnf("Error getting resource: %s", err.Error())
+			return models.Booking{}, err
+		}
+		current, err := b.bookingGetter.GetCurrentBooking(ctx, bookingType, resource.Id, time.Now())
+		if err != nil {
+			b.logger.Warnf("Error getting current booking: %s", err.Error())
+			return models.Booking{}, err
+		}
+		bookingId = current.BookingId
+	}
+
+	checkOut, err := b.bookingUpdater.CheckOutBooking(ctx, bookingType, bookingId, userId, checkTransition)
+	if err != nil {
+		b.logger.Warnf("Error checking out booking: %s", err.Error())
+		return models.Booking{}, err
+	}
+	booking := checkOut.Booking
+	if resource.Id == 0 {
+		resource, err = b.getResource(ctx, bookingType, booking.ResourceId)
+		if err != nil {
+			b.logger.Warnf("Error getting resource: %s", err.Error())
+			return booking, nil
+		}
+	}
+
+	now := time.Now()
+	err = b.clickhouseCreater.AddToClickHouse(ctx,
+		booking.BookingId,
+		resource.Id,
+		booking.UserId,
+		bookingType,
+		utills.StatusDone,
+		resource.Address,
+		resource.Zone,
+		resource.Floor,
+		resource.Number,
+		now,
+		now,
+		booking.StartTime,
+		booking.EndTime,
+		int64(math.Round(booking.EndTime.Sub(checkOut.CheckedInAt).Minutes())))
+	if err != nil {
+		b.logger.Warnf("Error adding to clickhouse: %s", err.Error())
+	}
+	if checkOut.PlannedEnd.After(booking.EndTime) {
+		b.promoteWaitlist(ctx, bookingType, booking.ResourceId, booking.EndTime, checkOut.PlannedEnd)
+	}
+	return booking, nil
+}
@@ -0,0 +1,94 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+)
+
+type DelegationStorage interface {
+	CreateDelegation(ctx context.Context, delegation models.Delegation) (models.Delegation, error)
+	DeleteDelegation(ctx context.Context, delegationId int64, principalId string) error
+	GetDelegations(ctx context.Context, userId string) ([]models.Delegation, error)
+	HasDelegation(ctx context.Context, delegateId, principalId, bookingType string) (bool, error)
+}
+
+// checkActsFor checks that actorId may book or cancel bookings of ownerId. Everyone acts for
+// themselves, others need a delegation for the booking type, an empty actor is nobody.
+func (b BookingService) checkActsFor(ctx context.Context, bookingType, actorId, ownerId string) error {
+	if actorId == "" {
+		return utills.ErrNotDelegate
+	}
+	if actorId == ownerId {
+		return nil
+	}
+	allowed, err := b.delegations.HasDelegation(ctx, actorId, ownerId, bookingType)
+	if err != nil {
+		b.logger.Warnf("Error checking delegation: %s", err.Error())
+		return err
+	}
+	if !allowed {
+		return utills.ErrNotDelegate
+	}
+	return nil
+}
+
+// checkOwner checks that actorId may change a booking of ownerId: the owner or their delegate.
+func (b BookingService) checkOwner(ctx context.Context, bookingType, actorId, ownerId string) error {
+	if err := b.checkActsFor(ctx, bookingType, actorId, ownerId); err != nil {
+		if errors.Is(err, utills.ErrNotDelegate) {
+			return utills.ErrNotBookingOwner
+		}
+		return err
+	}
+	return nil
+}
+
+// checkBookedBy checks that whoever books the booking may do it for its owner. An empty BookedBy
+// is the owner booking for themselves, BookedBy of the owner is cleared.
+func (b BookingService) checkBookedBy(ctx context.Context, bookingType string, booking models.Booking) (models.Booking, error) {
+	if booking.BookedBy == booking.UserId {
+		booking.BookedBy = ""
+	}
+	actorId := booking.BookedBy
+	if actorId == "" {
+		actorId = booking.UserId
+	}
+	if err := b.checkActsFor(ctx, bookingType, actorId, booking.UserId); err != nil {
+		b.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	return booking, nil
+}
+
+// CreateDelegation stores a delegation given by userId, only the principal can delegate their bookings.
+func (b BookingService) CreateDelegation(ctx context.Context, userId string, delegation models.Delegation) (models.Delegation, error) {
+	if userId == "" || userId != delegation.PrincipalId {
+		b.logger.Warnf("User %q can not delegate bookings of %s", userId, delegation.PrincipalId)
+		return models.Delegation{}, utills.ErrNotPrincipal
+	}
+	created, err := b.delegations.CreateDelegation(ctx, delegation)
+	if err != nil {
+		b.logger.Warnf("Error creating delegation: %s", err.Error())
+		return models.Delegation{}, err
+	}
+	return created, nil
+}
+
+func (b BookingService) DeleteDelegation(ctx context.Context, delegationId int64, principalId string) (bool, error) {
+	if err := b.delegations.DeleteDelegation(ctx, delegationId, principalId); err != nil {
+		b.logger.Warnf("Error deleting delegation: %s", err.Error())
+		return false, err
+	}
+	return true, nil
+}
+
+func (b BookingService) ListDelegations(ctx context.Context, userId string) ([]models.Delegation, error) {
+	delegations, err := b.delegations.GetDelegations(ctx, userId)
+	if err != nil {
+		b.logger.Warnf("Error getting delegations: %s", err.Error())
+		return nil, err
+	}
+	return delegations, nil
+}
@@ -0,0 +1,263 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/storage"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"slices"
+	"testing"
+	"time"
+)
+
+// fakeDelegations maps a delegate to the users they book for.
+type fakeDelegations struct {
+	DelegationStorage
+	principals map[string][]string
+}
+
+func (f fakeDelegations) HasDelegation(ctx context.Context, delegateId, principalId, bookingType string) (bool, error) {
+	return slices.Contains(f.principals[delegateId], principalId), nil
+}
+
+func (f fakeDelegations) CreateDelegation(ctx context.Context, delegation models.Delegation) (models.Delegation, error) {
+	delegation.Id = 1
+	return delegation, nil
+}
+
+func (f fakeChanges) CancelBooking(ctx context.Context, bookingType string, bookingId int64, cancelledBy, reason string, check storage.TransitionCheck) (models.Booking, error) {
+	*f.changes = append(*f.changes, change{changedBy: cancelledBy})
+	return models.Booking{BookingId: bookingId, ResourceId: 1, Status: utills.StatusCancelled}, nil
+}
+
+// UpdateBooking keeps the id of the updated booking in resourceId, an update names no resource.
+func (f fakeChanges) UpdateBooking(ctx context.Context, bookingID int64, updateFields []storage.Field, bookingType string, check storage.TransitionCheck) (models.Booking, error) {
+	*f.changes = append(*f.changes, change{resourceId: bookingID})
+	return models.Booking{BookingId: bookingID, ResourceId: 1, Status: utills.StatusPending}, nil
+}
+
+// fakeCreated records the bookings it is asked to create, and the events of guest bookings.
+type fakeCreated struct {
+	BookingCreater
+	created *[]models.Booking
+	events  *[]models.BookingEvent
+}
+
+func (f fakeCreated) CreateBookingSeries(ctx context.Context, bookingType, userId string, resourceId int64, rrule string, occurrences []models.Booking, check storage.QuotaCheck) (models.BookingSeries, error) {
+	*f.created = append(*f.created, occurrences...)
+	return models.BookingSeries{Bookings: occurrences}, nil
+}
+
+func (f fakeCreated) CreateBookingBundle(ctx context.Context, userId string, items []models.BundleBooking, check storage.QuotaCheck) (int64, []models.BundleBooking, error) {
+	for _, item := range items {
+		*f.created = append(*f.created, item.Booking)
+	}
+	return 1, items, nil
+}
+
+func TestCheckActsFor(t *testing.T) {
+	b := BookingService{
+		logger:      testLogger(),
+		delegations: fakeDelegations{principals: map[string][]string{"carol": {"alice"}}},
+	}
+
+	tests := []struct {
+		name    string
+		actorId string
+		ownerId string
+		wantErr error
+	}{
+		{name: "for themselves", actorId: "alice", ownerId: "alice"},
+		{name: "delegate", actorId: "carol", ownerId: "alice"},
+		{name: "delegate of another user", actorId: "carol", ownerId: "bob", wantErr: utills.ErrNotDelegate},
+		{name: "without a delegation", actorId: "bob", ownerId: "alice", wantErr: utills.ErrNotDelegate},
+		{name: "nobody", ownerId: "alice", wantErr: utills.ErrNotDelegate},
+		{name: "nobody for nobody", wantErr: utills.ErrNotDelegate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := b.checkActsFor(context.Background(), utills.WorkplaceType, tt.actorId, tt.ownerId)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			owner := b.checkOwner(context.Background(), utills.WorkplaceType, tt.actorId, tt.ownerId)
+			if (owner == nil) != (tt.wantErr == nil) || (owner != nil && !errors.Is(owner, utills.ErrNotBookingOwner)) {
+				t.Fatalf("checkOwner got error %v", owner)
+			}
+		})
+	}
+}
+
+func TestCheckBookedBy(t *testing.T) {
+	b := BookingService{
+		logger:      testLogger(),
+		delegations: fakeDelegations{principals: map[string][]string{"carol": {"alice"}}},
+	}
+
+	tests := []struct {
+		name         string
+		booking      models.Booking
+		wantErr      error
+		wantBookedBy string
+	}{
+		{name: "owner books", booking: models.Booking{UserId: "alice"}},
+		{name: "owner names themselves", booking: models.Booking{UserId: "alice", BookedBy: "alice"}},
+		{name: "delegate books", booking: models.Booking{UserId: "alice", BookedBy: "carol"}, wantBookedBy: "carol"},
+		{name: "another user books", booking: models.Booking{UserId: "alice", BookedBy: "bob"}, wantErr: utills.ErrNotDelegate},
+		{name: "booking of nobody", booking: models.Booking{BookedBy: "carol"}, wantErr: utills.ErrNotDelegate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			booking, err := b.checkBookedBy(context.Background(), utills.WorkplaceType, tt.booking)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if err == nil && booking.BookedBy != tt.wantBookedBy {
+				t.Fatalf("booked by %q, want %q", booking.BookedBy, tt.wantBookedBy)
+			}
+		})
+	}
+}
+
+func TestCreateDelegation(t *testing.T) {
+	b := BookingService{logger: testLogger(), delegations: fakeDelegations{}}
+	delegation := models.Delegation{DelegateId: "carol", PrincipalId: "alice"}
+
+	tests := []struct {
+		name    string
+		userId  string
+		wantErr error
+	}{
+		{name: "principal delegates", userId: "alice"},
+		{name: "delegate grants themselves", userId: "carol", wantErr: utills.ErrNotPrincipal},
+		{name: "another user", userId: "bob", wantErr: utills.ErrNotPrincipal},
+		{name: "nobody", wantErr: utills.ErrNotPrincipal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := b.CreateDelegation(context.Background(), tt.userId, delegation)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCancelBookingOwner(t *testing.T) {
+	tests := []struct {
+		name    string
+		userId  string
+		wantErr error
+		want    []change
+	}{
+		{name: "owner cancels", userId: "alice", want: []change{{changedBy: "alice"}}},
+		{name: "delegate cancels for the owner", userId: "carol", want: []change{{changedBy: "carol"}}},
+		{name: "nobody", wantErr: utills.ErrNotBookingOwner},
+		{name: "another user", userId: "bob", wantErr: utills.ErrNotBookingOwner},
+		{name: "delegate of another user", userId: "dave", wantErr: utills.ErrNotBookingOwner},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := make([]change, 0)
+			b := transferService(&changes, nil, utills.TransferPending)
+			_, err := b.CancelBooking(context.Background(), utills.WorkplaceType, 1, "", tt.userId, "")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			wantChanges(t, changes, tt.want)
+		})
+	}
+}
+
+func TestUpdateBookingOwner(t *testing.T) {
+	tests := []struct {
+		name    string
+		userId  string
+		wantErr error
+		want    []change
+	}{
+		{name: "owner updates", userId: "alice", want: []change{{resourceId: 1}}},
+		{name: "delegate updates for the owner", userId: "carol", want: []change{{resourceId: 1}}},
+		{name: "nobody", wantErr: utills.ErrNotBookingOwner},
+		{name: "another user", userId: "bob", wantErr: utills.ErrNotBookingOwner},
+		{name: "delegate of another user", userId: "dave", wantErr: utills.ErrNotBookingOwner},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := make([]change, 0)
+			b := transferService(&changes, nil, utills.TransferPending)
+			booking, err := b.bookingGetter.GetBookingsById(context.Background(), utills.WorkplaceType, 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = b.UpdateBooking(context.Background(), utills.WorkplaceType, "", 1, time.Time{}, booking.EndTime.Add(time.Hour), "", tt.userId)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			wantChanges(t, changes, tt.want)
+		})
+	}
+}
+
+func TestBookedBySeriesAndBundles(t *testing.T) {
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	create := map[string]func(b BookingService, bookedBy string) error{
+		"series": func(b BookingService, bookedBy string) error {
+			_, err := b.CreateRecurringBooking(context.Background(), utills.WorkplaceType, utills.StatusPending, start, start.Add(time.Hour), "alice", bookedBy, 1, "FREQ=DAILY;COUNT=2", nil)
+			return err
+		},
+		"bundle": func(b BookingService, bookedBy string) error {
+			_, _, err := b.CreateBookingBundle(context.Background(), "alice", bookedBy, []models.BundleBooking{
+				{BookingType: utills.WorkplaceType, Booking: models.Booking{ResourceId: 1, StartTime: start, EndTime: start.Add(time.Hour)}},
+				{BookingType: utills.WorkplaceType, Booking: models.Booking{ResourceId: 2, StartTime: start, EndTime: start.Add(time.Hour)}},
+			})
+			return err
+		},
+	}
+
+	tests := []struct {
+		name         string
+		bookedBy     string
+		wantErr      error
+		wantBookedBy string
+	}{
+		{name: "owner", bookedBy: ""},
+		{name: "owner named", bookedBy: "alice"},
+		{name: "delegate", bookedBy: "carol", wantBookedBy: "carol"},
+		{name: "delegate of another user", bookedBy: "dave", wantErr: utills.ErrNotDelegate},
+	}
+
+	for path, run := range create {
+		for _, tt := range tests {
+			t.Run(path+" "+tt.name, func(t *testing.T) {
+				created := make([]models.Booking, 0)
+				b := transferService(nil, nil, utills.TransferPending)
+				b.bookingCreater = fakeCreated{created: &created}
+				err := run(b, tt.bookedBy)
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("got error %v, want %v", err, tt.wantErr)
+				}
+				if err != nil {
+					if len(created) != 0 {
+						t.Fatalf("created %d bookings", len(created))
+					}
+					return
+				}
+				if len(created) != 2 {
+					t.Fatalf("created %d bookings, want 2", len(created))
+				}
+				for _, booking := range created {
+					if booking.BookedBy != tt.wantBookedBy {
+						t.Errorf("booked by %q, want %q", booking.BookedBy, tt.wantBookedBy)
+					}
+				}
+			})
+		}
+	}
+}
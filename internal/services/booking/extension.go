@@ -0,0 +1,115 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"sort"
+	"time"
+)
+
+// maxAlternatives is how many free resources are suggested when an extension collides.
+const maxAlternatives = 5
+
+// ExtendBooking moves the end of an active booking of userId by the given duration. The extension
+// goes through UpdateBooking, so it is checked against opening hours, policies and other bookings.
+func (b BookingService) ExtendBooking(ctx context.Context, bookingType string, bookingId int64, userId string, by time.Duration) (models.Booking, error) {
+	booking, err := b.bookingGetter.GetBookingsById(ctx, bookingType, bookingId)
+	if err != nil {
+		b.logger.Warnf("Error getting booking: %s", err.Error())
+		return models.Booking{}, err
+	}
+	if booking.UserId != userId {
+		b.logger.Warn(utills.ErrNotBookingOwner)
+		return models.Booking{}, utills.ErrNotBookingOwner
+	}
+	if booking.Status != utills.StatusPending && booking.Status != utills.StatusConfirmed {
+		b.logger.Warnf("Booking %d is %s", bookingId, booking.Status)
+		return models.Booking{}, utills.ErrBookingNotActive
+	}
+	return b.UpdateBooking(ctx, bookingType, "", bookingId, time.Time{}, booking.EndTime.Add(by), "", userId)
+}
+
+// isExtension reports whether the update keeps the start and only pushes the end further.
+func isExtension(current, updated models.Booking) bool {
+	return updated.StartTime.Equal(current.StartTime) && updated.EndTime.After(current.EndTime)
+}
+
+// extensionConflict turns a conflict of an extension into an ExtensionConflictError telling how
+// far the booking can still be extended and which resources nearby are free instead.
+func (b BookingService) extensionConflict(ctx context.Context, bookingType string, resource models.Resource, current models.Booking, requestedEnd time.Time, conflict error) error {
+	maxEnd := current.EndTime
+	if bookingType != utills.PoolType {
+		nextStart, err := b.bookingGetter.GetNextBlockedStart(ctx, bookingType, current.ResourceId, current.BookingId, current.BlockedEnd)
+		switch {
+		case errors.Is(err, utills.ErrNoRows):
+			// Конфликт не с бронированием, а с чем-то ещё, продлить нельзя.
+		case err != nil:
+			b.logger.Warnf("Error getting next booking: %s", err.Error())
+			return conflict
+		default:
+			maxEnd = maxExtendedEnd(current, nextStart, requestedEnd)
+		}
+	}
+	return &utills.ExtensionConflictError{
+		BookingId:              current.BookingId,
+		MaxEndTime:             maxEnd,
+		MaxExtension:           maxEnd.Sub(current.EndTime),
+		AlternativeResourceIds: b.alternativeResources(ctx, bookingType, resource, current.StartTime, requestedEnd),
+	}
+}
+
+// maxExtendedEnd returns how far the booking can be extended towards requestedEnd when the
+// resource is blocked again from nextStart, never earlier than its current end.
+func maxExtendedEnd(current models.Booking, nextStart, requestedEnd time.Time) time.Time {
+	// Буфер после бронирования тоже должен поместиться до следующего.
+	end := nextStart.Add(-current.BlockedEnd.Sub(current.EndTime))
+	if end.After(requestedEnd) {
+		end = requestedEnd
+	}
+	if end.Before(current.EndTime) {
+		end = current.EndTime
+	}
+	return end
+}
+
+// alternativeResources returns free resources of the same zone closest by floor to the given one.
+// They are only suggestions, so errors are logged and give no alternatives.
+func (b BookingService) alternativeResources(ctx context.Context, bookingType string, resource models.Resource, startTime, endTime time.Time) []int64 {
+	if bookingType == utills.PoolType {
+		return nil
+	}
+	if now := time.Now(); startTime.Before(now) {
+		startTime = now
+	}
+	resources, _, err := b.SearchAvailableResources(ctx, bookingType, startTime, endTime, models.ResourceFilter{Zone: resource.Zone}, 1)
+	if err != nil {
+		b.logger.Warnf("Error searching alternative resources: %s", err.Error())
+		return nil
+	}
+	free := make([]models.Resource, 0, len(resources))
+	for _, candidate := range resources {
+		if candidate.Id != resource.Id {
+			free = append(free, candidate)
+		}
+	}
+	sort.SliceStable(free, func(i, j int) bool {
+		return floorDistance(free[i], resource) < floorDistance(free[j], resource)
+	})
+	if len(free) > maxAlternatives {
+		free = free[:maxAlternatives]
+	}
+	ids := make([]int64, len(free))
+	for i, candidate := range free {
+		ids[i] = candidate.Id
+	}
+	return ids
+}
+
+func floorDistance(a, b models.Resource) int64 {
+	if a.Floor > b.Floor {
+		return a.Floor - b.Floor
+	}
+	return b.Floor - a.Floor
+}
@@ -0,0 +1,169 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"github.com/pedroxer/booking-service/internal/models"
+	proto_gen "github.com/pedroxer/booking-service/internal/proto_gen/protos"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"google.golang.org/grpc"
+	"slices"
+	"testing"
+	"time"
+)
+
+// fakeBookings serves bookings by id and the start of the next booking of a resource.
+type fakeBookings struct {
+	fakeOccupancy
+	bookings  map[int64]models.Booking
+	nextStart time.Time
+	nextErr   error
+}
+
+func (f fakeBookings) GetBookingsById(ctx context.Context, bookingType string, bookingId int64) (models.Booking, error) {
+	booking, ok := f.bookings[bookingId]
+	if !ok {
+		return models.Booking{}, utills.ErrNoRows
+	}
+	return booking, nil
+}
+
+func (f fakeBookings) GetNextBlockedStart(ctx context.Context, bookingType string, resourceId, excludeBookingId int64, after time.Time) (time.Time, error) {
+	return f.nextStart, f.nextErr
+}
+
+// fakeResources lists the workplaces of the resource service.
+type fakeResources struct {
+	proto_gen.ResourceServiceClient
+	workplaces []*proto_gen.Workplace
+}
+
+func (f fakeResources) GetWorkplaceById(ctx context.Context, in *proto_gen.GetWorkplaceByIdRequest, opts ...grpc.CallOption) (*proto_gen.Workplace, error) {
+	for _, workplace := range f.workplaces {
+		if workplace.Id == in.Id {
+			return workplace, nil
+		}
+	}
+	return nil, utills.ErrNoRows
+}
+
+func (f fakeResources) GetWorkplaces(ctx context.Context, in *proto_gen.GetWorkplacesRequest, opts ...grpc.CallOption) (*proto_gen.GetWorkplacesResponse, error) {
+	if in.Page > 1 {
+		return &proto_gen.GetWorkplacesResponse{PageSize: utills.PageSize}, nil
+	}
+	return &proto_gen.GetWorkplacesResponse{Workplaces: f.workplaces, PageSize: utills.PageSize}, nil
+}
+
+func TestMaxExtendedEnd(t *testing.T) {
+	start := time.Date(2024, time.March, 11, 10, 0, 0, 0, time.UTC)
+	current := models.Booking{StartTime: start, EndTime: start.Add(2 * time.Hour), BlockedEnd: start.Add(2*time.Hour + 15*time.Minute)}
+	requestedEnd := start.Add(4 * time.Hour)
+
+	tests := []struct {
+		name      string
+		nextStart time.Time
+		want      time.Time
+	}{
+		{name: "up to the next booking minus the buffer", nextStart: start.Add(3 * time.Hour), want: start.Add(2*time.Hour + 45*time.Minute)},
+		{name: "no further than requested", nextStart: start.Add(6 * time.Hour), want: requestedEnd},
+		{name: "buffer already touches the next booking", nextStart: current.BlockedEnd, want: current.EndTime},
+		{name: "never before the current end", nextStart: current.EndTime, want: current.EndTime},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := maxExtendedEnd(current, tt.nextStart, requestedEnd); !got.Equal(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtensionConflict(t *testing.T) {
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	current := models.Booking{
+		BookingId:  7,
+		ResourceId: 1,
+		StartTime:  start,
+		EndTime:    start.Add(2 * time.Hour),
+		BlockedEnd: start.Add(2 * time.Hour),
+	}
+	requestedEnd := start.Add(4 * time.Hour)
+	resource := models.Resource{Id: 1, Zone: "A", Floor: 3}
+	workplace := func(id, floor int64) *proto_gen.Workplace {
+		return &proto_gen.Workplace{Id: id, Zone: "A", Floor: floor, Number: id, Capacity: 1, IsAvailable: true}
+	}
+	resources := fakeResources{workplaces: []*proto_gen.Workplace{workplace(1, 3), workplace(2, 6), workplace(3, 3), workplace(4, 2)}}
+
+	tests := []struct {
+		name             string
+		bookingType      string
+		nextStart        time.Time
+		nextErr          error
+		wantMaxEnd       time.Time
+		wantAlternatives []int64
+	}{
+		{
+			name:             "extends up to the next booking",
+			bookingType:      utills.WorkplaceType,
+			nextStart:        start.Add(3 * time.Hour),
+			wantMaxEnd:       start.Add(3 * time.Hour),
+			wantAlternatives: []int64{3, 4, 2},
+		},
+		{
+			name:             "blocked by something else",
+			bookingType:      utills.WorkplaceType,
+			nextErr:          utills.ErrNoRows,
+			wantMaxEnd:       current.EndTime,
+			wantAlternatives: []int64{3, 4, 2},
+		},
+		{
+			name:        "pools have no next booking",
+			bookingType: utills.PoolType,
+			wantMaxEnd:  current.EndTime,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := BookingService{
+				logger:         testLogger(),
+				resourceClient: resources,
+				bookingGetter:  fakeBookings{nextStart: tt.nextStart, nextErr: tt.nextErr},
+				buffers:        fakeBuffers{},
+			}
+			err := b.extensionConflict(context.Background(), tt.bookingType, resource, current, requestedEnd, utills.ErrBookingConflict)
+			var conflict *utills.ExtensionConflictError
+			if !errors.As(err, &conflict) {
+				t.Fatalf("got error %v, want ExtensionConflictError", err)
+			}
+			if !conflict.MaxEndTime.Equal(tt.wantMaxEnd) {
+				t.Errorf("max end %v, want %v", conflict.MaxEndTime, tt.wantMaxEnd)
+			}
+			if conflict.MaxExtension != tt.wantMaxEnd.Sub(current.EndTime) {
+				t.Errorf("max extension %v, want %v", conflict.MaxExtension, tt.wantMaxEnd.Sub(current.EndTime))
+			}
+			if !slices.Equal(conflict.AlternativeResourceIds, tt.wantAlternatives) {
+				t.Errorf("alternatives %v, want %v", conflict.AlternativeResourceIds, tt.wantAlternatives)
+			}
+		})
+	}
+}
+
+func TestExtendBookingOwner(t *testing.T) {
+	b := BookingService{
+		logger: testLogger(),
+		bookingGetter: fakeBookings{bookings: map[int64]models.Booking{
+			1: {BookingId: 1, UserId: "alice", Status: utills.StatusPending},
+		}},
+	}
+
+	for _, userId := range []string{"", "bob"} {
+		t.Run("user "+userId, func(t *testing.T) {
+			_, err := b.ExtendBooking(context.Background(), utills.WorkplaceType, 1, userId, time.Hour)
+			if !errors.Is(err, utills.ErrNotBookingOwner) {
+				t.Fatalf("got error %v, want ErrNotBookingOwner", err)
+			}
+		})
+	}
+}
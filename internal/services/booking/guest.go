@@ -0,0 +1,182 @@
+package booking
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"math"
+	"sort"
+	"time"
+)
+
+// CreateGuestBooking books the resource for an external visitor. The booking belongs to the host
+// and counts against their limits, the guest gets a one-time code to check in with. The code is
+// returned only here, the host passes it on, the event notifying the host does not carry it.
+func (b BookingService) CreateGuestBooking(ctx context.Context, bookingType string, booking models.Booking, guest models.Guest) (models.GuestBooking, error) {
+	booking, err := b.prepareBooking(ctx, bookingType, booking)
+	if err != nil {
+		return models.GuestBooking{}, err
+	}
+	guest.CheckInCode, err = newCheckInCode()
+	if err != nil {
+		b.logger.Warnf("Error generating check-in code: %s", err.Error())
+		return models.GuestBooking{}, err
+	}
+	guest.CheckedInAt = nil
+
+	visit, err := b.bookingCreater.CreateGuestBooking(ctx, bookingType, booking, guest, models.BookingEvent{
+		EventType:   utills.EventGuestInvited,
+		UserId:      booking.UserId,
+		BookingType: bookingType,
+		Payload: map[string]string{
+			"guest_name": guest.Name,
+			"start_time": booking.StartTime.Format(time.RFC3339),
+			"end_time":   booking.EndTime.Format(time.RFC3339),
+		},
+	}, b.checkQuota)
+	if err != nil {
+		b.logger.Warnf("Error creating guest booking: %s", err.Error())
+		return models.GuestBooking{}, err
+	}
+	return visit, nil
+}
+
+// newCheckInCode returns 8 random characters, the host passes them on to the guest as a QR code.
+func newCheckInCode() (string, error) {
+	random := make([]byte, 5)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+	return base32.StdEncoding.EncodeToString(random), nil
+}
+
+// ApproveGuestBooking checks the guest in by their code.
+func (b BookingService) ApproveGuestBooking(ctx context.Context, code string) (models.Booking, error) {
+	visit, err := b.bookingUpdater.ApproveGuestBooking(ctx, code, b.checkGuestCheckIn)
+	if err != nil {
+		b.logger.Warnf("Error approving guest booking: %s", err.Error())
+		return models.Booking{}, err
+	}
+	booking := visit.Booking
+	resource, err := b.getResource(ctx, visit.BookingType, booking.ResourceId)
+	if err != nil {
+		b.logger.Warnf("Error getting resource: %s", err.Error())
+		return booking, nil
+	}
+	now := time.Now()
+	err = b.clickhouseCreater.AddToClickHouse(ctx,
+		booking.BookingId,
+		resource.Id,
+		booking.UserId,
+		visit.BookingType,
+		utills.StatusConfirmed,
+		resource.Address,
+		resource.Zone,
+		resource.Floor,
+		resource.Number,
+		now,
+		now,
+		booking.StartTime,
+		booking.EndTime,
+		int64(math.Round(booking.EndTime.Sub(booking.StartTime).Minutes())))
+	if err != nil {
+		b.logger.Warnf("Error adding to clickhouse: %s", err.Error())
+	}
+	return booking, nil
+}
+
+// checkGuestCheckIn lets the guest in once, from checkInBefore before the start of the visit
+// until its end.
+func (b BookingService) checkGuestCheckIn(visit models.GuestBooking, now time.Time) error {
+	if visit.Guest.CheckedInAt != nil {
+		// Использованный код больше не действует, как и неизвестный
+		return utills.ErrNoRows
+	}
+	booking := visit.Booking
+	if now.Before(booking.StartTime.Add(-b.checkInBefore)) || !now.Before(booking.EndTime) {
+		return utills.ErrOutsideCheckInWindow
+	}
+	return checkTransition(booking.Status, utills.StatusConfirmed)
+}
+
+// expireGuestBookings closes the visits of guests who never came.
+func (b BookingService) expireGuestBookings(ctx context.Context, bookingType string, now time.Time) {
+	expired, err := b.bookingUpdater.ExpireGuestBookings(ctx, bookingType, now)
+	if err != nil {
+		b.logger.Warnf("Error expiring guest bookings: %s", err.Error())
+		return
+	}
+	for _, booking := range expired {
+		b.logger.Infof("%s guest booking %d expired: the guest did not come", bookingType, booking.BookingId)
+		resource, err := b.getResource(ctx, bookingType, booking.ResourceId)
+		if err != nil {
+			b.logger.Warnf("Error getting resource: %s", err.Error())
+			continue
+		}
+		err = b.clickhouseCreater.AddToClickHouse(ctx,
+			booking.BookingId,
+			resource.Id,
+			booking.UserId,
+			bookingType,
+			utills.StatusExpired,
+			resource.Address,
+			resource.Zone,
+			resource.Floor,
+			resource.Number,
+			now,
+			now,
+			booking.StartTime,
+			booking.EndTime,
+			0)
+		if err != nil {
+			b.logger.Warnf("Error adding to clickhouse: %s", err.Error())
+		}
+	}
+}
+
+// ListVisitors returns the guest bookings of the day for reception. The day is taken in the time
+// zone of the office, an empty zone lists visitors of all offices in the default time zone.
+func (b BookingService) ListVisitors(ctx context.Context, date time.Time, zone string) ([]models.GuestBooking, error) {
+	location, err := b.zoneLocation(ctx, zone)
+	if err != nil {
+		return nil, err
+	}
+	dayStart := startOfDay(date.In(location))
+	dayEnd := dayStart.AddDate(0, 0, 1)
+
+	visitors := make([]models.GuestBooking, 0)
+	for _, bookingType := range noShowBookingTypes {
+		visits, err := b.bookingGetter.GetGuestBookings(ctx, bookingType, dayStart, dayEnd)
+		if err != nil {
+			b.logger.Warnf("Error getting guest bookings: %s", err.Error())
+			return nil, err
+		}
+		zones := make(map[int64]string)
+		for _, visit := range visits {
+			if zone != "" {
+				resourceZone, ok := zones[visit.Booking.ResourceId]
+				if !ok {
+					resource, err := b.getResource(ctx, bookingType, visit.Booking.ResourceId)
+					if err != nil {
+						b.logger.Warnf("Error getting resource: %s", err.Error())
+						return nil, err
+					}
+					resourceZone = resource.Zone
+					zones[visit.Booking.ResourceId] = resourceZone
+				}
+				if resourceZone != zone {
+					continue
+				}
+			}
+			// Код знает только гость, ресепшен отмечает его по QR гостя
+			visit.Guest.CheckInCode = ""
+			visitors = append(visitors, visit)
+		}
+	}
+	sort.SliceStable(visitors, func(i, j int) bool {
+		return visitors[i].Booking.StartTime.Before(visitors[j].Booking.StartTime)
+	})
+	return visitors, nil
+}
@@ -0,0 +1,161 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/prometheus"
+	"github.com/pedroxer/booking-service/internal/storage"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"sync"
+	"testing"
+	"time"
+)
+
+// metricsOnce registers the metrics released bookings are counted in, once per test binary.
+var metricsOnce sync.Once
+
+// fakeReleases serves guest bookings to expire and bookings to mark as no-show, for workplaces
+// only, and keeps the deadlines it was asked for.
+type fakeReleases struct {
+	BookingUpdater
+	expired   []models.Booking
+	noShows   []models.Booking
+	deadlines map[string]time.Time
+}
+
+func (f fakeReleases) ExpireGuestBookings(ctx context.Context, bookingType string, deadline time.Time) ([]models.Booking, error) {
+	f.deadlines["expire "+bookingType] = deadline
+	if bookingType != utills.WorkplaceType {
+		return nil, nil
+	}
+	return f.expired, nil
+}
+
+func (f fakeReleases) MarkNoShowBookings(ctx context.Context, bookingType string, since, deadline time.Time) ([]models.Booking, error) {
+	f.deadlines["no-show "+bookingType] = deadline
+	if bookingType != utills.WorkplaceType {
+		return nil, nil
+	}
+	return f.noShows, nil
+}
+
+func (f fakeCreated) CreateGuestBooking(ctx context.Context, bookingType string, booking models.Booking, guest models.Guest, event models.BookingEvent, check storage.QuotaCheck) (models.GuestBooking, error) {
+	if check == nil {
+		return models.GuestBooking{}, utills.ErrNoRows
+	}
+	*f.created = append(*f.created, booking)
+	*f.events = append(*f.events, event)
+	return models.GuestBooking{BookingType: bookingType, Booking: booking, Guest: guest}, nil
+}
+
+func TestCheckGuestCheckIn(t *testing.T) {
+	start := time.Date(2024, time.March, 11, 10, 0, 0, 0, time.UTC)
+	checkedIn := start
+	b := BookingService{checkInBefore: 15 * time.Minute}
+	visit := func(status string, checkedInAt *time.Time) models.GuestBooking {
+		return models.GuestBooking{
+			Booking: models.Booking{StartTime: start, EndTime: start.Add(2 * time.Hour), Status: status},
+			Guest:   models.Guest{CheckedInAt: checkedInAt},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		visit   models.GuestBooking
+		now     time.Time
+		wantErr error
+	}{
+		{name: "too early", visit: visit(utills.StatusPending, nil), now: start.Add(-16 * time.Minute), wantErr: utills.ErrOutsideCheckInWindow},
+		{name: "window opens before the start", visit: visit(utills.StatusPending, nil), now: start.Add(-15 * time.Minute)},
+		{name: "after the start", visit: visit(utills.StatusPending, nil), now: start.Add(90 * time.Minute)},
+		{name: "until the end of the visit", visit: visit(utills.StatusPending, nil), now: start.Add(2*time.Hour - time.Second)},
+		{name: "visit is over", visit: visit(utills.StatusPending, nil), now: start.Add(2 * time.Hour), wantErr: utills.ErrOutsideCheckInWindow},
+		{name: "code is used once", visit: visit(utills.StatusConfirmed, &checkedIn), now: start.Add(time.Hour), wantErr: utills.ErrNoRows},
+		{name: "host cancelled the visit", visit: visit(utills.StatusCancelled, nil), now: start, wantErr: utills.ErrInvalidTransition},
+		{name: "visit has expired", visit: visit(utills.StatusExpired, nil), now: start, wantErr: utills.ErrInvalidTransition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := b.checkGuestCheckIn(tt.visit, tt.now); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReleaseNoShows(t *testing.T) {
+	metricsOnce.Do(func() {
+		if err := prometheus.MetricsInit(); err != nil {
+			t.Fatal(err)
+		}
+	})
+	past := time.Now().Add(-3 * time.Hour)
+	booking := func(id int64) models.Booking {
+		return models.Booking{BookingId: id, UserId: "alice", ResourceId: 1, StartTime: past, EndTime: past.Add(time.Hour), Status: utills.StatusPending}
+	}
+	releases := fakeReleases{
+		expired:   []models.Booking{booking(5)},
+		noShows:   []models.Booking{booking(6)},
+		deadlines: make(map[string]time.Time),
+	}
+	reported := make(map[int64]string)
+	b := transferService(nil, nil, utills.TransferPending)
+	b.bookingUpdater = releases
+	b.clickhouseCreater = fakeClickhouse{reported: reported}
+	b.checkInAfter = 30 * time.Minute
+
+	before := time.Now()
+	b.releaseNoShows(context.Background())
+	after := time.Now()
+
+	for _, bookingType := range noShowBookingTypes {
+		expire, ok := releases.deadlines["expire "+bookingType]
+		if !ok || expire.Before(before) || expire.After(after) {
+			t.Errorf("%s guest bookings expired by %v, want now", bookingType, expire)
+		}
+		noShow, ok := releases.deadlines["no-show "+bookingType]
+		if !ok || noShow.Sub(expire) != -b.checkInAfter {
+			t.Errorf("%s bookings released by %v, want %v", bookingType, noShow, expire.Add(-b.checkInAfter))
+		}
+	}
+	want := map[int64]string{5: utills.StatusExpired, 6: utills.StatusNoShow}
+	if len(reported) != len(want) {
+		t.Fatalf("reported %v, want %v", reported, want)
+	}
+	for id, status := range want {
+		if reported[id] != status {
+			t.Errorf("booking %d reported as %s, want %s", id, reported[id], status)
+		}
+	}
+}
+
+func TestCreateGuestBooking(t *testing.T) {
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	created := make([]models.Booking, 0)
+	events := make([]models.BookingEvent, 0)
+	b := transferService(nil, nil, utills.TransferPending)
+	b.bookingCreater = fakeCreated{created: &created, events: &events}
+
+	visit, err := b.CreateGuestBooking(context.Background(), utills.WorkplaceType,
+		models.Booking{UserId: "alice", ResourceId: 1, StartTime: start, EndTime: start.Add(time.Hour), Status: utills.StatusPending},
+		models.Guest{Name: "Guest", Email: "guest@example.com", CheckInCode: "chosen"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(visit.Guest.CheckInCode) != 8 || visit.Guest.CheckInCode == "chosen" {
+		t.Fatalf("got check-in code %q, want a new one of 8 characters", visit.Guest.CheckInCode)
+	}
+	if len(events) != 1 {
+		t.Fatalf("got %d events, want 1", len(events))
+	}
+	if events[0].UserId != "alice" || events[0].EventType != utills.EventGuestInvited {
+		t.Errorf("got event %+v, want the host to be notified", events[0])
+	}
+	for key, value := range events[0].Payload {
+		if value == visit.Guest.CheckInCode || key == "guest_email" {
+			t.Errorf("event payload keeps %s", key)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package booking
+
+import (
+	"context"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"time"
+)
+
+// GetAvailabilityMatrix splits [startTime, endTime) into cells of granularity and marks for every
+// resource which cells are taken by a booking, for a pool when all its places are taken.
+// Resources are given by id or, when resourceIds is empty, by the zone and floor filter.
+func (b BookingService) GetAvailabilityMatrix(ctx context.Context, bookingType string, resourceIds []int64, filter models.ResourceFilter, startTime, endTime time.Time, granularity time.Duration) ([]models.ResourceOccupancy, error) {
+	if len(resourceIds) == 0 {
+		resources, err := b.listResources(ctx, bookingType, filter)
+		if err != nil {
+			b.logger.Warnf("Error listing resources: %s", err.Error())
+			return nil, err
+		}
+		for _, resource := range resources {
+			resourceIds = append(resourceIds, resource.Id)
+		}
+	}
+	if len(resourceIds) == 0 {
+		return []models.ResourceOccupancy{}, nil
+	}
+
+	cells := matrixCells(startTime, endTime, granularity)
+	rows := make(map[int64][]byte, len(resourceIds))
+	matrix := make([]models.ResourceOccupancy, 0, len(resourceIds))
+	for _, id := range resourceIds {
+		if _, ok := rows[id]; ok {
+			continue
+		}
+		rows[id] = make([]byte, (cells+7)/8)
+		matrix = append(matrix, models.ResourceOccupancy{ResourceId: id, Occupancy: rows[id]})
+	}
+	if bookingType == utills.PoolType {
+		if err := b.markFullPools(ctx, rows, resourceIds, startTime, endTime, granularity, cells); err != nil {
+			return nil, err
+		}
+		return matrix, nil
+	}
+
+	bookings, err := b.bookingGetter.GetResourcesOccupancy(ctx, bookingType, resourceIds, startTime, endTime)
+	if err != nil {
+		b.logger.Warnf("Error getting occupancy: %s", err.Error())
+		return nil, err
+	}
+	for _, booking := range bookings {
+		markBusy(rows[booking.ResourceId], startTime, granularity, cells, booking.StartTime, booking.EndTime)
+	}
+	return matrix, nil
+}
+
+// markFullPools marks the cells in which all places of a pool are taken, a pool with free
+// places left is not busy.
+func (b BookingService) markFullPools(ctx context.Context, rows map[int64][]byte, poolIds []int64, startTime, endTime time.Time, granularity time.Duration, cells int) error {
+	pools, err := b.listResources(ctx, utills.PoolType, models.ResourceFilter{})
+	if err != nil {
+		b.logger.Warnf("Error listing pools: %s", err.Error())
+		return err
+	}
+	capacities := make(map[int64]int64, len(pools))
+	for _, pool := range pools {
+		capacities[pool.Id] = pool.Capacity
+	}
+	occupancy, err := b.occupancy(ctx, utills.PoolType, poolIds, startTime, endTime)
+	if err != nil {
+		return err
+	}
+	for id, row := range rows {
+		for _, period := range poolFullPeriods(startTime, endTime, occupancy[id], capacities[id]) {
+			markBusy(row, startTime, granularity, cells, period.StartTime, period.EndTime)
+		}
+	}
+	return nil
+}
+
+func matrixCells(startTime, endTime time.Time, granularity time.Duration) int {
+	return int((endTime.Sub(startTime) + granularity - 1) / granularity)
+}
+
+// markBusy sets the bits of the cells that [from, to) touches. Cell i is bit i%8 of byte i/8.
+func markBusy(bitmap []byte, startTime time.Time, granularity time.Duration, cells int, from, to time.Time) {
+	if bitmap == nil {
+		return
+	}
+	first := int(from.Sub(startTime) / granularity)
+	if from.Before(startTime) {
+		first = 0
+	}
+	last := int((to.Sub(startTime) + granularity - 1) / granularity)
+	if last > cells {
+		last = cells
+	}
+	for cell := first; cell < last; cell++ {
+		bitmap[cell/8] |= 1 << (cell % 8)
+	}
+}
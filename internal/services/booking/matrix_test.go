@@ -0,0 +1,65 @@
+package booking
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestMatrixCells(t *testing.T) {
+	start := time.Date(2024, time.March, 11, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		end         time.Time
+		granularity time.Duration
+		want        int
+	}{
+		{name: "whole cells", end: start.Add(2 * time.Hour), granularity: 30 * time.Minute, want: 4},
+		{name: "partial last cell", end: start.Add(70 * time.Minute), granularity: 30 * time.Minute, want: 3},
+		{name: "shorter than a cell", end: start.Add(5 * time.Minute), granularity: time.Hour, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matrixCells(start, tt.end, tt.granularity); got != tt.want {
+				t.Fatalf("got %d cells, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkBusy(t *testing.T) {
+	start := time.Date(2024, time.March, 11, 8, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time {
+		return start.Add(time.Duration(minutes) * time.Minute)
+	}
+	const granularity = 30 * time.Minute
+	const cells = 12
+
+	tests := []struct {
+		name     string
+		from, to time.Time
+		want     []byte
+	}{
+		{name: "one cell", from: at(0), to: at(30), want: []byte{0b00000001, 0}},
+		{name: "touched cells are busy", from: at(40), to: at(100), want: []byte{0b00001110, 0}},
+		{name: "crosses a byte", from: at(210), to: at(300), want: []byte{0b10000000, 0b00000011}},
+		{name: "starts before the range", from: at(-60), to: at(45), want: []byte{0b00000011, 0}},
+		{name: "ends after the range", from: at(300), to: at(600), want: []byte{0, 0b00001100}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bitmap := make([]byte, (cells+7)/8)
+			markBusy(bitmap, start, granularity, cells, tt.from, tt.to)
+			if !bytes.Equal(bitmap, tt.want) {
+				t.Fatalf("got %08b, want %08b", bitmap, tt.want)
+			}
+		})
+	}
+
+	t.Run("unknown resource", func(t *testing.T) {
+		markBusy(nil, start, granularity, cells, at(0), at(30))
+	})
+}
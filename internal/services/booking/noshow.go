@@ -0,0 +1,102 @@
+package booking
+
+import (
+	"context"
+	"github.com/pedroxer/booking-service/internal/prometheus"
+	"github.com/pedroxer/booking-service/internal/utills"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+const defaultNoShowInterval = time.Minute
+
+// Check-in window used when the config does not set it.
+const (
+	defaultCheckInBefore = 15 * time.Minute
+	defaultCheckInAfter  = 30 * time.Minute
+)
+
+// noShowBookingTypes lists booking types that can be checked in by QR, only they can be released
+// and booked for guests.
+var noShowBookingTypes = []string{utills.WorkplaceType, utills.ParkingType, utills.RoomType}
+
+// RunNoShowReleaser periodically releases pending bookings whose check-in window is over
+// and expires the visits of guests who did not come.
+func (b BookingService) RunNoShowReleaser(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = defaultNoShowInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			b.releaseNoShows(ctx)
+		}
+	}
+}
+
+func (b BookingService) releaseNoShows(ctx context.Context) {
+	for _, bookingType := range noShowBookingTypes {
+		now := time.Now()
+		b.expireGuestBookings(ctx, bookingType, now)
+		released, err := b.bookingUpdater.MarkNoShowBookings(ctx, bookingType, b.noShowSince, now.Add(-b.checkInAfter))
+		if err != nil {
+			b.logger.Warnf("Error releasing no-show bookings: %s", err.Error())
+			continue
+		}
+		for _, booking := range released {
+			b.logger.Infof("%s booking %d released: nobody checked in", bookingType, booking.BookingId)
+			resource, err := b.getResource(ctx, bookingType, booking.ResourceId)
+			if err != nil {
+				b.logger.Warnf("Error getting resource: %s", err.Error())
+				continue
+			}
+			prometheus.IncrementReleasedBookings(resource.Zone)
+			err = b.clickhouseCreater.AddToClickHouse(ctx,
+				booking.BookingId,
+				resource.Id,
+				booking.UserId,
+				bookingType,
+				utills.StatusNoShow,
+				resource.Address,
+				resource.Zone,
+				resource.Floor,
+				resource.Number,
+				now,
+				now,
+				booking.StartTime,
+				booking.EndTime,
+				0)
+			if err != nil {
+				b.logger.Warnf("Error adding to clickhouse: %s", err.Error())
+			}
+			if booking.EndTime.After(now) {
+				b.promoteWaitlist(ctx, bookingType, booking.ResourceId, now, booking.EndTime)
+			}
+		}
+	}
+}
+
+func minutesOr(minutes int, fallback time.Duration) time.Duration {
+	if minutes <= 0 {
+		return fallback
+	}
+	return time.Duration(minutes) * time.Minute
+}
+
+// noShowSince returns the start of the bookings the releaser looks at. Without a configured
+// cutoff it is the start of the service less the check-in window: older bookings were released
+// by the previous run, and the first run after the deploy does not touch the history.
+func noShowSince(logger *log.Logger, since string, checkInAfter time.Duration) time.Time {
+	if since != "" {
+		parsed, err := time.Parse(time.RFC3339, since)
+		if err == nil {
+			return parsed
+		}
+		logger.Warnf("Invalid no-show cutoff %q, using the start of the service: %s", since, err.Error())
+	}
+	return time.Now().Add(-checkInAfter)
+}
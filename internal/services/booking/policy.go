@@ -0,0 +1,195 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"github.com/pedroxer/booking-service/internal/config"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/storage"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Policy violation reasons, returned to clients in the error details.
+const (
+	reasonMaxDuration    = "MAX_DURATION_EXCEEDED"
+	reasonAdvanceHorizon = "ADVANCE_HORIZON_EXCEEDED"
+	reasonLeadTime       = "LEAD_TIME_TOO_SHORT"
+	reasonMaxActive      = "MAX_ACTIVE_BOOKINGS_EXCEEDED"
+	reasonMaxDaysPerWeek = "MAX_DAYS_PER_WEEK_EXCEEDED"
+	reasonZoneNotAllowed = "ZONE_NOT_ALLOWED"
+)
+
+type PolicyStorage interface {
+	GetPolicy(ctx context.Context, bookingType string) (models.Policy, error)
+	GetUserGroups(ctx context.Context, userId string) ([]string, error)
+	CountActiveBookings(ctx context.Context, bookingType, userId string) (int64, error)
+	GetUserBookingStarts(ctx context.Context, bookingType, userId string, from, to time.Time, excludeBookingId int64) ([]time.Time, error)
+}
+
+func policiesFromConfig(policies map[string]config.Policy) map[string]models.Policy {
+	converted := make(map[string]models.Policy, len(policies))
+	for bookingType, policy := range policies {
+		converted[bookingType] = models.Policy{
+			MaxDuration:       time.Duration(policy.MaxDurationMinutes) * time.Minute,
+			MaxAdvance:        time.Duration(policy.MaxAdvanceDays) * 24 * time.Hour,
+			MinLeadTime:       time.Duration(policy.MinLeadMinutes) * time.Minute,
+			MaxActiveBookings: int64(policy.MaxActiveBookings),
+			MaxDaysPerWeek:    int64(policy.MaxDaysPerWeek),
+			ZoneGroups:        policy.ZoneGroups,
+		}
+	}
+	return converted
+}
+
+// policyFor returns the policy of the booking type, the one in the database wins over config.
+func (b BookingService) policyFor(ctx context.Context, bookingType string) (models.Policy, error) {
+	policy, err := b.policies.GetPolicy(ctx, bookingType)
+	if errors.Is(err, utills.ErrNoRows) {
+		return b.defaultPolicies[bookingType], nil
+	}
+	return policy, err
+}
+
+// checkPolicy validates the booking against the policy of its type. previous is the booking
+// before an update and nil for a new one: an update does not add a booking, so the limits
+// on the number of bookings and on zones are only checked for new ones.
+func (b BookingService) checkPolicy(ctx context.Context, bookingType, zone string, booking models.Booking, previous *models.Booking) error {
+	policy, err := b.policyFor(ctx, bookingType)
+	if err != nil {
+		b.logger.Warnf("Error getting policy: %s", err.Error())
+		return err
+	}
+	now := time.Now()
+
+	if policy.MaxDuration > 0 && booking.EndTime.Sub(booking.StartTime) > policy.MaxDuration {
+		return policyViolation(reasonMaxDuration, policy.MaxDuration.String())
+	}
+	if previous == nil || !booking.StartTime.Equal(previous.StartTime) {
+		if policy.MaxAdvance > 0 && booking.StartTime.Sub(now) > policy.MaxAdvance {
+			return policyViolation(reasonAdvanceHorizon, policy.MaxAdvance.String())
+		}
+		if policy.MinLeadTime > 0 && booking.StartTime.Sub(now) < policy.MinLeadTime {
+			return policyViolation(reasonLeadTime, policy.MinLeadTime.String())
+		}
+	}
+	if previous == nil {
+		if err := b.checkZoneGroups(ctx, policy, zone, booking.UserId); err != nil {
+			return err
+		}
+		if err := b.checkActiveBookings(ctx, b.policies, bookingType, policy, booking.UserId); err != nil {
+			return err
+		}
+	}
+	if policy.MaxDaysPerWeek > 0 {
+		location, err := b.zoneLocation(ctx, zone)
+		if err != nil {
+			return err
+		}
+		booking.StartTime = booking.StartTime.In(location)
+		if previous == nil || !sameDay(booking.StartTime, previous.StartTime) {
+			return b.checkDaysPerWeek(ctx, b.policies, bookingType, policy, booking)
+		}
+	}
+	return nil
+}
+
+// checkQuota repeats the limits of checkPolicy on the bookings the user holds when storage
+// inserts a new booking, held reads them in the inserting transaction.
+func (b BookingService) checkQuota(ctx context.Context, bookingType string, booking models.Booking, held storage.UserBookings) error {
+	policy, err := b.policyFor(ctx, bookingType)
+	if err != nil {
+		b.logger.Warnf("Error getting policy: %s", err.Error())
+		return err
+	}
+	if err := b.checkActiveBookings(ctx, held, bookingType, policy, booking.UserId); err != nil {
+		return err
+	}
+	if policy.MaxDaysPerWeek > 0 {
+		location := b.location
+		if booking.TimeZone != "" {
+			if zoneLocation, err := time.LoadLocation(booking.TimeZone); err == nil {
+				location = zoneLocation
+			}
+		}
+		booking.StartTime = booking.StartTime.In(location)
+		return b.checkDaysPerWeek(ctx, held, bookingType, policy, booking)
+	}
+	return nil
+}
+
+func (b BookingService) checkZoneGroups(ctx context.Context, policy models.Policy, zone, userId string) error {
+	allowed, ok := policy.ZoneGroups[zone]
+	if !ok {
+		return nil
+	}
+	groups, err := b.policies.GetUserGroups(ctx, userId)
+	if err != nil {
+		b.logger.Warnf("Error getting user groups: %s", err.Error())
+		return err
+	}
+	for _, group := range groups {
+		if slices.Contains(allowed, group) {
+			return nil
+		}
+	}
+	return policyViolation(reasonZoneNotAllowed, zone)
+}
+
+func (b BookingService) checkActiveBookings(ctx context.Context, held storage.UserBookings, bookingType string, policy models.Policy, userId string) error {
+	if policy.MaxActiveBookings <= 0 {
+		return nil
+	}
+	active, err := held.CountActiveBookings(ctx, bookingType, userId)
+	if err != nil {
+		b.logger.Warnf("Error counting active bookings: %s", err.Error())
+		return err
+	}
+	if active >= policy.MaxActiveBookings {
+		return policyViolation(reasonMaxActive, strconv.FormatInt(policy.MaxActiveBookings, 10))
+	}
+	return nil
+}
+
+// checkDaysPerWeek counts the distinct days of the Monday-based week the user already has
+// bookings on, another booking on one of these days does not use up a new day. Days are taken
+// in the location of the booking start, which is the time zone of the office.
+func (b BookingService) checkDaysPerWeek(ctx context.Context, held storage.UserBookings, bookingType string, policy models.Policy, booking models.Booking) error {
+	weekStart := startOfWeek(booking.StartTime)
+	starts, err := held.GetUserBookingStarts(ctx, bookingType, booking.UserId, weekStart, weekStart.AddDate(0, 0, 7), booking.BookingId)
+	if err != nil {
+		b.logger.Warnf("Error getting user bookings: %s", err.Error())
+		return err
+	}
+	days := make(map[time.Time]struct{})
+	for _, start := range starts {
+		days[startOfDay(start.In(booking.StartTime.Location()))] = struct{}{}
+	}
+	if _, ok := days[startOfDay(booking.StartTime)]; ok {
+		return nil
+	}
+	if int64(len(days)) >= policy.MaxDaysPerWeek {
+		return policyViolation(reasonMaxDaysPerWeek, strconv.FormatInt(policy.MaxDaysPerWeek, 10))
+	}
+	return nil
+}
+
+func policyViolation(reason, limit string) error {
+	return &utills.PolicyViolationError{Reason: reason, Limit: limit}
+}
+
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
+func startOfWeek(t time.Time) time.Time {
+	day := startOfDay(t)
+	return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+}
+
+func sameDay(a, b time.Time) bool {
+	return startOfDay(a).Equal(startOfDay(b.In(a.Location())))
+}
@@ -0,0 +1,238 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"testing"
+	"time"
+)
+
+// fakePolicies keeps the policy and the bookings of the user in memory. Methods the tests
+// do not expect are left to the nil embedded interface and panic.
+type fakePolicies struct {
+	PolicyStorage
+	policy models.Policy
+	groups []string
+	active int64
+	starts []time.Time
+}
+
+func (f fakePolicies) GetPolicy(ctx context.Context, bookingType string) (models.Policy, error) {
+	return f.policy, nil
+}
+
+func (f fakePolicies) GetUserGroups(ctx context.Context, userId string) ([]string, error) {
+	return f.groups, nil
+}
+
+func (f fakePolicies) CountActiveBookings(ctx context.Context, bookingType, userId string) (int64, error) {
+	return f.active, nil
+}
+
+func (f fakePolicies) GetUserBookingStarts(ctx context.Context, bookingType, userId string, from, to time.Time, excludeBookingId int64) ([]time.Time, error) {
+	starts := make([]time.Time, 0)
+	for _, start := range f.starts {
+		if !start.Before(from) && start.Before(to) {
+			starts = append(starts, start)
+		}
+	}
+	return starts, nil
+}
+
+// fakeCalendars knows no zone, so every zone is in the default time zone of the service and
+// open by the default hours, with no holidays and no blackouts.
+type fakeCalendars struct {
+	CalendarStorage
+}
+
+func (fakeCalendars) GetZoneTimeZone(ctx context.Context, zone string) (string, error) {
+	return "", utills.ErrNoRows
+}
+
+func (fakeCalendars) GetOpeningHours(ctx context.Context, zone string) ([]models.OpeningHours, error) {
+	return nil, nil
+}
+
+func (fakeCalendars) GetHolidays(ctx context.Context, zone string, from, to time.Time) ([]models.Holiday, error) {
+	return nil, nil
+}
+
+func (fakeCalendars) GetBlackouts(ctx context.Context, bookingType string, resourceId int64, from, to time.Time) ([]models.Blackout, error) {
+	return nil, nil
+}
+
+func testLogger() *log.Logger {
+	logger := log.New()
+	logger.SetOutput(io.Discard)
+	return logger
+}
+
+func wantViolation(t *testing.T, err error, reason string) {
+	t.Helper()
+	if reason == "" {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		return
+	}
+	var violation *utills.PolicyViolationError
+	if !errors.As(err, &violation) {
+		t.Fatalf("got error %v, want %s", err, reason)
+	}
+	if violation.Reason != reason {
+		t.Fatalf("got reason %s, want %s", violation.Reason, reason)
+	}
+}
+
+func TestStartOfWeek(t *testing.T) {
+	moscow := time.FixedZone("MSK", 3*60*60)
+	// 2024-03-11 is a Monday.
+	monday := time.Date(2024, time.March, 11, 0, 0, 0, 0, moscow)
+
+	tests := []struct {
+		name string
+		at   time.Time
+		want time.Time
+	}{
+		{name: "monday morning", at: monday.Add(9 * time.Hour), want: monday},
+		{name: "midweek", at: time.Date(2024, time.March, 13, 15, 30, 0, 0, moscow), want: monday},
+		{name: "sunday belongs to the past week", at: time.Date(2024, time.March, 17, 23, 59, 0, 0, moscow), want: monday},
+		{name: "next monday starts a week", at: time.Date(2024, time.March, 18, 0, 0, 0, 0, moscow), want: monday.AddDate(0, 0, 7)},
+		{name: "across a month", at: time.Date(2024, time.March, 2, 12, 0, 0, 0, moscow), want: time.Date(2024, time.February, 26, 0, 0, 0, 0, moscow)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := startOfWeek(tt.at)
+			if !got.Equal(tt.want) || got.Location() != moscow {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckDaysPerWeek(t *testing.T) {
+	moscow := time.FixedZone("MSK", 3*60*60)
+	// 2024-03-11 is a Monday.
+	day := func(day, hour int) time.Time {
+		return time.Date(2024, time.March, 11+day, hour, 0, 0, 0, moscow)
+	}
+	policy := models.Policy{MaxDaysPerWeek: 2}
+	held := fakePolicies{starts: []time.Time{
+		day(0, 9),
+		day(1, 9),
+		// Monday 22:00 in UTC is already Tuesday in Moscow.
+		day(0, 22).UTC(),
+		day(7, 9),
+	}}
+	b := BookingService{logger: testLogger()}
+
+	tests := []struct {
+		name  string
+		start time.Time
+		want  string
+	}{
+		{name: "another booking on a booked day", start: day(1, 14)},
+		{name: "a third day", start: day(2, 9), want: reasonMaxDaysPerWeek},
+		{name: "sunday of the same week", start: day(6, 9), want: reasonMaxDaysPerWeek},
+		{name: "next week has its own days", start: day(8, 9)},
+		{name: "past week", start: day(-1, 9)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			booking := models.Booking{UserId: "alice", StartTime: tt.start, EndTime: tt.start.Add(time.Hour)}
+			wantViolation(t, b.checkDaysPerWeek(context.Background(), held, utills.WorkplaceType, policy, booking), tt.want)
+		})
+	}
+}
+
+func TestCheckPolicy(t *testing.T) {
+	now := time.Now()
+	inDays := func(days int) time.Time {
+		return now.Add(time.Duration(days) * 24 * time.Hour)
+	}
+	policy := models.Policy{
+		MaxDuration:       4 * time.Hour,
+		MaxAdvance:        14 * 24 * time.Hour,
+		MinLeadTime:       time.Hour,
+		MaxActiveBookings: 3,
+		ZoneGroups:        map[string][]string{"lab": {"engineers"}},
+	}
+	booking := func(start time.Time, duration time.Duration) models.Booking {
+		return models.Booking{UserId: "alice", StartTime: start, EndTime: start.Add(duration)}
+	}
+	tomorrow := booking(inDays(1), 2*time.Hour)
+
+	tests := []struct {
+		name     string
+		zone     string
+		groups   []string
+		active   int64
+		booking  models.Booking
+		previous *models.Booking
+		want     string
+	}{
+		{name: "within every limit", booking: tomorrow},
+		{name: "too long", booking: booking(inDays(1), 5*time.Hour), want: reasonMaxDuration},
+		{name: "too far ahead", booking: booking(inDays(15), time.Hour), want: reasonAdvanceHorizon},
+		{name: "too soon", booking: booking(now.Add(30*time.Minute), time.Hour), want: reasonLeadTime},
+		{name: "zone of another group", zone: "lab", groups: []string{"sales"}, booking: tomorrow, want: reasonZoneNotAllowed},
+		{name: "zone of the user group", zone: "lab", groups: []string{"sales", "engineers"}, booking: tomorrow},
+		{name: "no active bookings left", active: 3, booking: tomorrow, want: reasonMaxActive},
+		{name: "update keeps a start closer than the lead time", booking: booking(now.Add(30*time.Minute), 2*time.Hour), previous: &models.Booking{StartTime: now.Add(30 * time.Minute)}},
+		{name: "update moving the start too soon", booking: booking(now.Add(30*time.Minute), time.Hour), previous: &tomorrow, want: reasonLeadTime},
+		{name: "update does not add an active booking", active: 3, booking: booking(inDays(1), 3*time.Hour), previous: &tomorrow},
+		{name: "update is checked for duration", booking: booking(inDays(1), 5*time.Hour), previous: &tomorrow, want: reasonMaxDuration},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := BookingService{
+				logger:    testLogger(),
+				policies:  fakePolicies{policy: policy, groups: tt.groups, active: tt.active},
+				calendars: fakeCalendars{},
+				location:  time.UTC,
+			}
+			wantViolation(t, b.checkPolicy(context.Background(), utills.WorkplaceType, tt.zone, tt.booking, tt.previous), tt.want)
+		})
+	}
+}
+
+func TestCheckQuota(t *testing.T) {
+	// 2024-03-11 is a Monday.
+	monday := time.Date(2024, time.March, 11, 9, 0, 0, 0, time.UTC)
+	b := BookingService{
+		logger:   testLogger(),
+		policies: fakePolicies{policy: models.Policy{MaxActiveBookings: 2, MaxDaysPerWeek: 1}},
+		location: time.UTC,
+	}
+
+	tests := []struct {
+		name    string
+		held    fakePolicies
+		booking models.Booking
+		want    string
+	}{
+		{name: "nothing held", booking: models.Booking{StartTime: monday}},
+		{name: "active bookings come from held", held: fakePolicies{active: 2}, booking: models.Booking{StartTime: monday}, want: reasonMaxActive},
+		{name: "day already used", held: fakePolicies{starts: []time.Time{monday.AddDate(0, 0, 1)}}, booking: models.Booking{StartTime: monday}, want: reasonMaxDaysPerWeek},
+		{
+			// Sunday 21:00 in UTC is Monday in the time zone of the booking.
+			name:    "days are counted in the booking time zone",
+			held:    fakePolicies{starts: []time.Time{monday.AddDate(0, 0, 1)}},
+			booking: models.Booking{StartTime: monday.Add(-12 * time.Hour), TimeZone: "Europe/Moscow"},
+			want:    reasonMaxDaysPerWeek,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wantViolation(t, b.checkQuota(context.Background(), utills.WorkplaceType, tt.booking, tt.held), tt.want)
+		})
+	}
+}
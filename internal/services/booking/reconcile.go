@@ -0,0 +1,124 @@
+package booking
+
+import (
+	"context"
+	"fmt"
+	"github.com/pedroxer/booking-service/internal/models"
+	proto_gen "github.com/pedroxer/booking-service/internal/proto_gen/protos"
+	"github.com/pedroxer/booking-service/internal/utills"
+)
+
+// ReconcileAvailability makes bookable again the resources that were switched off by
+// the old booking flow (CreateBooking used to set is_available = false and only
+// CancelBooking switched it back). A resource is repaired when it is unavailable,
+// has bookings and carries no maintenance status, i.e. nobody disabled it on purpose.
+func (b BookingService) ReconcileAvailability(ctx context.Context, bookingType string, dryRun bool) ([]int64, error) {
+	bookedIds, err := b.bookingGetter.GetBookedResourceIds(ctx, bookingType)
+	if err != nil {
+		b.logger.Warnf("Error getting booked resources: %s", err.Error())
+		return nil, err
+	}
+	booked := make(map[int64]bool, len(bookedIds))
+	for _, id := range bookedIds {
+		booked[id] = true
+	}
+
+	resources, err := b.listResources(ctx, bookingType, models.ResourceFilter{})
+	if err != nil {
+		b.logger.Warnf("Error listing resources: %s", err.Error())
+		return nil, err
+	}
+
+	repaired := make([]int64, 0)
+	for _, resource := range resources {
+		if resource.IsAvailable || !booked[resource.Id] || resource.MaintenanceStatus != "" {
+			continue
+		}
+		if !dryRun {
+			if err := b.setResourceAvailable(ctx, bookingType, resource.Id); err != nil {
+				b.logger.Warnf("Error updating resource %d: %s", resource.Id, err.Error())
+				return repaired, err
+			}
+			b.logger.Infof("%s %d is available again", bookingType, resource.Id)
+		}
+		repaired = append(repaired, resource.Id)
+	}
+	return repaired, nil
+}
+
+// listResources reads all pages of resources matching the filter from the resource service,
+// pools come from our storage.
+func (b BookingService) listResources(ctx context.Context, bookingType string, filter models.ResourceFilter) ([]models.Resource, error) {
+	resources := make([]models.Resource, 0)
+	for page := int64(1); ; page++ {
+		var batch []models.Resource
+		var pageSize int64
+		switch bookingType {
+		case utills.WorkplaceType, utills.RoomType:
+			resp, err := b.resourceClient.GetWorkplaces(ctx, &proto_gen.GetWorkplacesRequest{
+				Zone:      filter.Zone,
+				Floor:     filter.Floor,
+				Type:      filter.Type,
+				WithItems: len(filter.ItemTypes) > 0,
+				Page:      page,
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, workplace := range resp.Workplaces {
+				batch = append(batch, workplaceToResource(workplace))
+			}
+			pageSize = resp.PageSize
+		case utills.PoolType:
+			// Пулы хранятся у нас и читаются одним запросом
+			pools, err := b.bookingGetter.GetPools(ctx, filter.Zone)
+			if err != nil {
+				return nil, err
+			}
+			for _, pool := range pools {
+				if (filter.Floor == 0 || pool.Floor == filter.Floor) && (filter.Type == "" || pool.ResourceType == filter.Type) {
+					resources = append(resources, poolToResource(pool))
+				}
+			}
+			return resources, nil
+		case utills.ParkingType:
+			resp, err := b.resourceClient.GetParkingSpaces(ctx, &proto_gen.GetParkingSpacesRequest{
+				Zone: filter.Zone,
+				Type: filter.Type,
+				Page: page,
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, parking := range resp.ParkingSpaces {
+				batch = append(batch, parkingToResource(parking))
+			}
+			pageSize = resp.PageSize
+		default:
+			return nil, fmt.Errorf("booking type %s not supported", bookingType)
+		}
+		resources = append(resources, batch...)
+		if len(batch) == 0 || int64(len(batch)) < pageSize {
+			return resources, nil
+		}
+	}
+}
+
+func (b BookingService) setResourceAvailable(ctx context.Context, bookingType string, resourceId int64) error {
+	var err error
+	switch bookingType {
+	case utills.WorkplaceType:
+		_, err = b.resourceClient.UpdateWorkplace(ctx, &proto_gen.UpdateWorkplaceRequest{
+			Id:          resourceId,
+			IsAvailable: true,
+		})
+	case utills.ParkingType:
+		_, err = b.resourceClient.UpdateParkingSpace(ctx, &proto_gen.UpdateParkingSpaceRequest{
+			Id:          resourceId,
+			IsAvailable: true,
+		})
+	default:
+		err = fmt.Errorf("booking type %s not supported", bookingType)
+	}
+	return err
+}
@@ -0,0 +1,154 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/storage"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"sort"
+	"time"
+)
+
+// CreateRecurringBooking books every occurrence of the rule together with the series. Every
+// occurrence is validated like a single booking: one that collides with an existing booking,
+// falls into a closed period or breaks a policy is reported in the result instead of failing
+// the series. bookedBy books for userId with a delegation, like in CreateBooking. The rule is
+// expanded in the time zone of the resource office, so occurrences keep their local time across
+// DST changes.
+func (b BookingService) CreateRecurringBooking(ctx context.Context, bookingType, status string, startTime, endTime time.Time, userId, bookedBy string, resourceId int64, rule string, exDates []time.Time) (models.BookingSeries, error) {
+	owner, err := b.checkBookedBy(ctx, bookingType, models.Booking{UserId: userId, BookedBy: bookedBy})
+	if err != nil {
+		return models.BookingSeries{}, err
+	}
+	parsed, err := parseRRule(rule)
+	if err != nil {
+		b.logger.Warnf("Error parsing rrule: %s", err.Error())
+		return models.BookingSeries{}, err
+	}
+
+	resource, err := b.getResource(ctx, bookingType, resourceId)
+	if err != nil {
+		b.logger.Warn("Error getting resource ", err)
+		return models.BookingSeries{}, err
+	}
+	if !resource.IsAvailable {
+		b.logger.Warn("Resource is not available")
+		return models.BookingSeries{}, utills.ErrResourceUnavailable
+	}
+	location, err := b.zoneLocation(ctx, resource.Zone)
+	if err != nil {
+		return models.BookingSeries{}, err
+	}
+	occurrences, err := parsed.expand(startTime.In(location), exDates)
+	if err != nil {
+		b.logger.Warnf("Error expanding rrule: %s", err.Error())
+		return models.BookingSeries{}, err
+	}
+
+	duration := endTime.Sub(startTime)
+	bookings := make([]models.Booking, 0, len(occurrences))
+	skipped := make([]models.OccurrenceConflict, 0)
+	for _, occurrence := range occurrences {
+		booking, err := b.prepareFor(ctx, bookingType, resource, models.Booking{
+			UserId:     userId,
+			ResourceId: resourceId,
+			StartTime:  occurrence,
+			EndTime:    occurrence.Add(duration),
+			Status:     status,
+			BookedBy:   owner.BookedBy,
+		})
+		if errors.Is(err, utills.ErrPolicyViolation) || errors.Is(err, utills.ErrResourceClosed) {
+			skipped = append(skipped, models.OccurrenceConflict{
+				StartTime: occurrence,
+				EndTime:   occurrence.Add(duration),
+				Reason:    err.Error(),
+			})
+			continue
+		}
+		if err != nil {
+			return models.BookingSeries{}, err
+		}
+		bookings = append(bookings, booking)
+	}
+	series, err := b.bookingCreater.CreateBookingSeries(ctx, bookingType, userId, resourceId, rule, bookings, b.checkQuota)
+	if err != nil {
+		b.logger.Warnf("Error creating booking series: %s", err.Error())
+		return models.BookingSeries{}, err
+	}
+	series.Conflicts = append(series.Conflicts, skipped...)
+	sort.SliceStable(series.Conflicts, func(i, j int) bool {
+		return series.Conflicts[i].StartTime.Before(series.Conflicts[j].StartTime)
+	})
+	return series, nil
+}
+
+func seriesFrom(booking models.Booking, scope string) time.Time {
+	if scope == utills.ScopeWholeSeries {
+		return time.Time{}
+	}
+	return booking.StartTime
+}
+
+// updateSeries moves every affected occurrence by the same offsets the request applies
+// to the given one, so the time of day changes but the dates of the series stay. Every moved
+// occurrence is validated like the given one: when one falls into a closed period or breaks a
+// policy, the series is not changed and the error names that occurrence.
+func (b BookingService) updateSeries(ctx context.Context, bookingType, status string, booking models.Booking, resource models.Resource, startTime, endTime time.Time, scope string) (models.Booking, error) {
+	var startShift, endShift time.Duration
+	if !startTime.IsZero() {
+		startShift = startTime.Sub(booking.StartTime)
+	}
+	if !endTime.IsZero() {
+		endShift = endTime.Sub(booking.EndTime)
+	}
+	updateFields := make([]storage.Field, 0)
+	if status != "" {
+		updateFields = append(updateFields, storage.Field{
+			Name:  "status",
+			Value: status,
+		})
+	}
+
+	bookings, err := b.bookingUpdater.UpdateSeriesBookings(ctx, bookingType, booking.SeriesId, seriesFrom(booking, scope), startShift, endShift, updateFields, checkTransition, b.checkShift(ctx, bookingType, resource))
+	if err != nil {
+		b.logger.Warnf("Error updating booking series: %s", err.Error())
+		return models.Booking{}, err
+	}
+	for _, updated := range bookings {
+		if updated.BookingId == booking.BookingId {
+			return updated, nil
+		}
+	}
+	return models.Booking{}, utills.ErrNoRows
+}
+
+// checkShift validates an occurrence at the times it is moved to against the calendar and the
+// policies, the way an update of a single booking is.
+func (b BookingService) checkShift(ctx context.Context, bookingType string, resource models.Resource) storage.ShiftCheck {
+	return func(current, shifted models.Booking) error {
+		err := b.checkOpen(ctx, bookingType, resource, shifted.StartTime, shifted.EndTime)
+		if err == nil {
+			err = b.checkPolicy(ctx, bookingType, resource.Zone, shifted, &current)
+		}
+		if err != nil {
+			return fmt.Errorf("occurrence at %s: %w", current.StartTime.Format(time.RFC3339), err)
+		}
+		return nil
+	}
+}
+
+func (b BookingService) cancelSeries(ctx context.Context, bookingType string, booking models.Booking, scope, userId, reason string) (bool, error) {
+	// Прошедшие и подтверждённые повторения не отменяются
+	cancelled, err := b.bookingUpdater.CancelSeriesBookings(ctx, bookingType, booking.SeriesId, seriesFrom(booking, scope), statusesAllowing(utills.StatusCancelled), userId, reason)
+	if err != nil {
+		b.logger.Warnf("Error cancelling booking series: %s", err.Error())
+		return false, err
+	}
+	b.logger.Infof("cancelled %d bookings of series %d", len(cancelled), booking.SeriesId)
+	for _, occurrence := range cancelled {
+		b.bookingCancelled(ctx, bookingType, occurrence)
+	}
+	return true, nil
+}
@@ -0,0 +1,105 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/storage"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"testing"
+	"time"
+)
+
+// fakeSeries moves the occurrences of a series the way storage does and records them when
+// every one of them passes the shift check.
+type fakeSeries struct {
+	BookingUpdater
+	occurrences []models.Booking
+	moved       *[]models.Booking
+}
+
+func (f fakeSeries) UpdateSeriesBookings(ctx context.Context, bookingType string, seriesId int64, from time.Time, startShift, endShift time.Duration, updateFields []storage.Field, check storage.TransitionCheck, shift storage.ShiftCheck) ([]models.Booking, error) {
+	moved := make([]models.Booking, 0, len(f.occurrences))
+	for _, current := range f.occurrences {
+		if current.StartTime.Before(from) {
+			continue
+		}
+		shifted := current
+		shifted.StartTime = current.StartTime.Add(startShift)
+		shifted.EndTime = current.EndTime.Add(endShift)
+		if err := shift(current, shifted); err != nil {
+			return nil, err
+		}
+		moved = append(moved, shifted)
+	}
+	*f.moved = append(*f.moved, moved...)
+	return moved, nil
+}
+
+func TestUpdateSeries(t *testing.T) {
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	occurrences := make([]models.Booking, 0, 3)
+	for day := 0; day < 3; day++ {
+		occurrenceStart := start.AddDate(0, 0, day)
+		occurrences = append(occurrences, models.Booking{BookingId: int64(day + 1), SeriesId: 7, UserId: "alice", ResourceId: 1,
+			StartTime: occurrenceStart, EndTime: occurrenceStart.Add(time.Hour), Status: utills.StatusPending})
+	}
+
+	tests := []struct {
+		name       string
+		scope      string
+		closed     bool
+		policy     models.Policy
+		wantErr    error
+		wantReason string
+		wantMoved  int
+	}{
+		{name: "whole series moves", scope: utills.ScopeWholeSeries, wantMoved: 3},
+		{name: "this and following move", scope: utills.ScopeThisAndFollowing, wantMoved: 3},
+		{name: "last occurrence leaves the booking horizon", scope: utills.ScopeWholeSeries,
+			policy: models.Policy{MaxAdvance: 73 * time.Hour}, wantReason: reasonAdvanceHorizon},
+		{name: "occurrences fall into a closed period", scope: utills.ScopeWholeSeries, closed: true, wantErr: utills.ErrResourceClosed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			moved := make([]models.Booking, 0)
+			b := transferService(nil, nil, utills.TransferPending)
+			b.bookingGetter = fakeTransfers{fakeBookings: fakeBookings{bookings: map[int64]models.Booking{1: occurrences[0]}}}
+			b.bookingUpdater = fakeSeries{occurrences: occurrences, moved: &moved}
+			b.policies = fakePolicies{policy: tt.policy}
+			if tt.closed {
+				// Only the third day is closed, the given occurrence passes the checks of UpdateBooking.
+				b.calendars = fakeClosedFrom{from: start.AddDate(0, 0, 2)}
+			}
+
+			_, err := b.UpdateBooking(context.Background(), utills.WorkplaceType, "", 1, start.Add(2*time.Hour), start.Add(3*time.Hour), tt.scope, "alice")
+			if tt.wantReason != "" {
+				wantViolation(t, err, tt.wantReason)
+			} else if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if len(moved) != tt.wantMoved {
+				t.Fatalf("moved %d occurrences, want %d", len(moved), tt.wantMoved)
+			}
+			for i, booking := range moved {
+				if want := occurrences[i].StartTime.Add(2 * time.Hour); !booking.StartTime.Equal(want) {
+					t.Errorf("occurrence %d starts at %v, want %v", i, booking.StartTime, want)
+				}
+			}
+		})
+	}
+}
+
+// fakeClosedFrom closes every resource from the given time on.
+type fakeClosedFrom struct {
+	fakeCalendars
+	from time.Time
+}
+
+func (f fakeClosedFrom) GetBlackouts(ctx context.Context, bookingType string, resourceId int64, from, to time.Time) ([]models.Blackout, error) {
+	if !to.After(f.from) {
+		return nil, nil
+	}
+	return []models.Blackout{{ResourceId: resourceId, StartTime: f.from, EndTime: to.Add(time.Hour)}}, nil
+}
@@ -0,0 +1,119 @@
+package booking
+
+import (
+	"context"
+	"fmt"
+	"github.com/pedroxer/booking-service/internal/models"
+	proto_gen "github.com/pedroxer/booking-service/internal/proto_gen/protos"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"strconv"
+	"strings"
+)
+
+const parkingFloor = -1
+
+func (b BookingService) getResource(ctx context.Context, bookingType string, resourceId int64) (models.Resource, error) {
+	switch bookingType {
+	case utills.WorkplaceType, utills.RoomType:
+		workplace, err := b.resourceClient.GetWorkplaceById(ctx, &proto_gen.GetWorkplaceByIdRequest{Id: resourceId})
+		if err != nil {
+			return models.Resource{}, err
+		}
+		return workplaceToResource(workplace), nil
+	case utills.ParkingType:
+		parking, err := b.resourceClient.GetParkingSpaceById(ctx, &proto_gen.GetParkingSpaceByIdRequest{Id: resourceId})
+		if err != nil {
+			return models.Resource{}, err
+		}
+		return parkingToResource(parking), nil
+	case utills.PoolType:
+		pool, err := b.bookingGetter.GetPool(ctx, resourceId)
+		if err != nil {
+			return models.Resource{}, err
+		}
+		return poolToResource(pool), nil
+	default:
+		return models.Resource{}, fmt.Errorf("booking type %s not supported", bookingType)
+	}
+}
+
+func (b BookingService) getResourceByTag(ctx context.Context, bookingType, uniqueTag string) (models.Resource, error) {
+	switch bookingType {
+	case utills.WorkplaceType, utills.RoomType:
+		workplace, err := b.resourceClient.GetWorkplaceByUniqueTag(ctx, &proto_gen.GetWorkplaceByUniqueTagRequest{UniqueTag: uniqueTag})
+		if err != nil {
+			return models.Resource{}, err
+		}
+		return workplaceToResource(workplace), nil
+	case utills.ParkingType:
+		// У парковочных мест нет своих меток в сервисе ресурсов, код на табличке места - его id
+		parkingId, err := strconv.ParseInt(strings.TrimSpace(uniqueTag), 10, 64)
+		if err != nil {
+			return models.Resource{}, utills.ErrNoRows
+		}
+		return b.getResource(ctx, bookingType, parkingId)
+	default:
+		return models.Resource{}, fmt.Errorf("booking type %s not supported", bookingType)
+	}
+}
+
+// bookingLicencePlate keeps the licence plate for parking bookings only.
+func bookingLicencePlate(bookingType, plate string) string {
+	if bookingType != utills.ParkingType {
+		return ""
+	}
+	return normalizeLicencePlate(plate)
+}
+
+// normalizeLicencePlate makes plates typed by users comparable with plates read by the gate camera.
+func normalizeLicencePlate(plate string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(plate), ""))
+}
+
+func workplaceToResource(workplace *proto_gen.Workplace) models.Resource {
+	return models.Resource{
+		Id:                workplace.Id,
+		Address:           workplace.Address,
+		Zone:              workplace.Zone,
+		Floor:             workplace.Floor,
+		Number:            workplace.Number,
+		Type:              workplace.Type,
+		Capacity:          workplace.Capacity,
+		IsAvailable:       workplace.IsAvailable,
+		MaintenanceStatus: workplace.MaintenanceStatus,
+		ItemTypes:         itemTypes(workplace.Items),
+	}
+}
+
+func itemTypes(items []*proto_gen.Item) []string {
+	types := make([]string, 0, len(items))
+	for _, item := range items {
+		types = append(types, item.Type)
+	}
+	return types
+}
+
+func parkingToResource(parking *proto_gen.ParkingSpace) models.Resource {
+	return models.Resource{
+		Id:          parking.Id,
+		Address:     parking.Address,
+		Zone:        parking.Zone,
+		Floor:       parkingFloor,
+		Number:      parking.Number,
+		Type:        parking.Type,
+		Capacity:    1,
+		IsAvailable: parking.IsAvailable,
+	}
+}
+
+func poolToResource(pool models.ResourcePool) models.Resource {
+	return models.Resource{
+		Id:          pool.Id,
+		Address:     pool.Name,
+		Zone:        pool.Zone,
+		Floor:       pool.Floor,
+		Type:        pool.ResourceType,
+		Capacity:    pool.Capacity,
+		IsAvailable: pool.IsAvailable,
+	}
+}
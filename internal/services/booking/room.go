@@ -0,0 +1,43 @@
+package booking
+
+import (
+	"context"
+	"fmt"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+)
+
+// roomAttendees drops duplicates and the organiser from the invited users and checks that
+// everybody, the organiser included, fits into the room.
+func roomAttendees(resource models.Resource, organiserId string, attendees []models.Attendee) ([]models.Attendee, error) {
+	seen := map[string]bool{organiserId: true}
+	unique := make([]models.Attendee, 0, len(attendees))
+	for _, attendee := range attendees {
+		if attendee.UserId == "" || seen[attendee.UserId] {
+			continue
+		}
+		seen[attendee.UserId] = true
+		unique = append(unique, attendee)
+	}
+	if int64(len(unique)+1) > resource.Capacity {
+		return nil, fmt.Errorf("%w: %d people, capacity %d", utills.ErrRoomCapacityExceeded, len(unique)+1, resource.Capacity)
+	}
+	return unique, nil
+}
+
+func (b BookingService) RespondToInvitation(ctx context.Context, bookingId int64, userId string, accept bool) (models.Booking, error) {
+	status := utills.AttendeeDeclined
+	if accept {
+		status = utills.AttendeeAccepted
+	}
+	if err := b.bookingUpdater.RespondToInvitation(ctx, bookingId, userId, status); err != nil {
+		b.logger.Warnf("Error responding to invitation: %s", err.Error())
+		return models.Booking{}, err
+	}
+	booking, err := b.bookingGetter.GetBookingsById(ctx, utills.RoomType, bookingId)
+	if err != nil {
+		b.logger.Warnf("Error getting booking: %s", err.Error())
+		return models.Booking{}, err
+	}
+	return booking, nil
+}
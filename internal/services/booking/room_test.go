@@ -0,0 +1,54 @@
+package booking
+
+import (
+	"errors"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"testing"
+)
+
+func TestRoomAttendees(t *testing.T) {
+	room := models.Resource{Id: 1, Capacity: 3}
+	invite := func(userIds ...string) []models.Attendee {
+		attendees := make([]models.Attendee, 0, len(userIds))
+		for _, userId := range userIds {
+			attendees = append(attendees, models.Attendee{UserId: userId})
+		}
+		return attendees
+	}
+
+	tests := []struct {
+		name      string
+		attendees []models.Attendee
+		want      []string
+		wantErr   bool
+	}{
+		{name: "nobody invited", want: []string{}},
+		{name: "fills the room", attendees: invite("bob", "eve"), want: []string{"bob", "eve"}},
+		{name: "duplicates and organiser are dropped", attendees: invite("bob", "alice", "bob", "", "eve"), want: []string{"bob", "eve"}},
+		{name: "one too many", attendees: invite("bob", "eve", "mallory"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := roomAttendees(room, "alice", tt.attendees)
+			if tt.wantErr {
+				if !errors.Is(err, utills.ErrRoomCapacityExceeded) {
+					t.Fatalf("got error %v, want ErrRoomCapacityExceeded", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i].UserId != tt.want[i] {
+					t.Errorf("attendee %d = %s, want %s", i, got[i].UserId, tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,297 @@
+package booking
+
+import (
+	"fmt"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const maxOccurrences = 366
+
+const (
+	freqDaily   = "DAILY"
+	freqWeekly  = "WEEKLY"
+	freqMonthly = "MONTHLY"
+)
+
+var rruleWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+type byDay struct {
+	ordinal int // 0 - каждый такой день, для MONTHLY можно указать 1MO, -1FR
+	weekday time.Weekday
+}
+
+// rrule is the subset of RFC 5545 recurrence rules supported for bookings:
+// FREQ=DAILY|WEEKLY|MONTHLY with INTERVAL, BYDAY, BYMONTHDAY, COUNT and UNTIL.
+type rrule struct {
+	freq       string
+	interval   int
+	count      int
+	until      time.Time
+	byDay      []byDay
+	byMonthDay []int
+}
+
+func parseRRule(value string) (rrule, error) {
+	rule := rrule{interval: 1}
+	value = strings.TrimPrefix(strings.TrimSpace(value), "RRULE:")
+	if value == "" {
+		return rrule{}, fmt.Errorf("%w: empty rule", utills.ErrInvalidRRule)
+	}
+	for _, part := range strings.Split(value, ";") {
+		key, val, ok := strings.Cut(part, "=")
+		if !ok {
+			return rrule{}, fmt.Errorf("%w: bad part %q", utills.ErrInvalidRRule, part)
+		}
+		var err error
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			rule.freq = strings.ToUpper(val)
+			if rule.freq != freqDaily && rule.freq != freqWeekly && rule.freq != freqMonthly {
+				return rrule{}, fmt.Errorf("%w: FREQ=%s is not supported", utills.ErrInvalidRRule, val)
+			}
+		case "INTERVAL":
+			rule.interval, err = strconv.Atoi(val)
+			if err != nil || rule.interval < 1 {
+				return rrule{}, fmt.Errorf("%w: bad INTERVAL %q", utills.ErrInvalidRRule, val)
+			}
+		case "COUNT":
+			rule.count, err = strconv.Atoi(val)
+			if err != nil || rule.count < 1 {
+				return rrule{}, fmt.Errorf("%w: bad COUNT %q", utills.ErrInvalidRRule, val)
+			}
+		case "UNTIL":
+			rule.until, err = parseRRuleTime(val)
+			if err != nil {
+				return rrule{}, fmt.Errorf("%w: bad UNTIL %q", utills.ErrInvalidRRule, val)
+			}
+		case "BYDAY":
+			for _, day := range strings.Split(val, ",") {
+				parsed, err := parseByDay(day)
+				if err != nil {
+					return rrule{}, err
+				}
+				rule.byDay = append(rule.byDay, parsed)
+			}
+		case "BYMONTHDAY":
+			for _, day := range strings.Split(val, ",") {
+				monthDay, err := strconv.Atoi(day)
+				if err != nil || monthDay == 0 || monthDay < -31 || monthDay > 31 {
+					return rrule{}, fmt.Errorf("%w: bad BYMONTHDAY %q", utills.ErrInvalidRRule, day)
+				}
+				rule.byMonthDay = append(rule.byMonthDay, monthDay)
+			}
+		case "WKST":
+			if strings.ToUpper(val) != "MO" {
+				return rrule{}, fmt.Errorf("%w: only WKST=MO is supported", utills.ErrInvalidRRule)
+			}
+		default:
+			return rrule{}, fmt.Errorf("%w: %s is not supported", utills.ErrInvalidRRule, key)
+		}
+	}
+	if rule.freq == "" {
+		return rrule{}, fmt.Errorf("%w: FREQ is required", utills.ErrInvalidRRule)
+	}
+	if rule.count == 0 && rule.until.IsZero() {
+		return rrule{}, fmt.Errorf("%w: rule must be bounded by COUNT or UNTIL", utills.ErrInvalidRRule)
+	}
+	if rule.count != 0 && !rule.until.IsZero() {
+		return rrule{}, fmt.Errorf("%w: COUNT and UNTIL are mutually exclusive", utills.ErrInvalidRRule)
+	}
+	for _, day := range rule.byDay {
+		if day.ordinal != 0 && rule.freq != freqMonthly {
+			return rrule{}, fmt.Errorf("%w: BYDAY ordinals are only allowed with FREQ=MONTHLY", utills.ErrInvalidRRule)
+		}
+	}
+	if len(rule.byMonthDay) > 0 && rule.freq != freqMonthly {
+		return rrule{}, fmt.Errorf("%w: BYMONTHDAY is only allowed with FREQ=MONTHLY", utills.ErrInvalidRRule)
+	}
+	return rule, nil
+}
+
+func parseByDay(value string) (byDay, error) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if len(value) < 2 {
+		return byDay{}, fmt.Errorf("%w: bad BYDAY %q", utills.ErrInvalidRRule, value)
+	}
+	weekday, ok := rruleWeekdays[value[len(value)-2:]]
+	if !ok {
+		return byDay{}, fmt.Errorf("%w: bad BYDAY %q", utills.ErrInvalidRRule, value)
+	}
+	result := byDay{weekday: weekday}
+	if prefix := value[:len(value)-2]; prefix != "" {
+		ordinal, err := strconv.Atoi(prefix)
+		if err != nil || ordinal == 0 || ordinal < -5 || ordinal > 5 {
+			return byDay{}, fmt.Errorf("%w: bad BYDAY %q", utills.ErrInvalidRRule, value)
+		}
+		result.ordinal = ordinal
+	}
+	return result, nil
+}
+
+func parseRRuleTime(value string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405", "20060102"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			if layout == "20060102" {
+				t = t.Add(24*time.Hour - time.Second)
+			}
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unknown time format %q", value)
+}
+
+// expand returns the start times of the occurrences generated from dtStart. As in
+// RFC 5545, COUNT limits the generated set before exDates are removed. Supported
+// frequencies give at most one occurrence per day, so exDates are matched by date.
+func (r rrule) expand(dtStart time.Time, exDates []time.Time) ([]time.Time, error) {
+	excluded := make(map[string]bool, len(exDates))
+	for _, exDate := range exDates {
+		excluded[exDate.In(dtStart.Location()).Format(time.DateOnly)] = true
+	}
+	occurrences := make([]time.Time, 0)
+	generated := 0
+	for period := 0; period < maxOccurrences*12; period++ {
+		if !r.until.IsZero() && r.periodStart(dtStart, period).After(r.until) {
+			break
+		}
+		for _, candidate := range r.periodCandidates(dtStart, period) {
+			if candidate.Before(dtStart) {
+				continue
+			}
+			if !r.until.IsZero() && candidate.After(r.until) {
+				return occurrences, nil
+			}
+			if generated == maxOccurrences {
+				return nil, fmt.Errorf("%w: rule expands to more than %d occurrences", utills.ErrInvalidRRule, maxOccurrences)
+			}
+			generated++
+			if !excluded[candidate.Format(time.DateOnly)] {
+				occurrences = append(occurrences, candidate)
+			}
+			if r.count != 0 && generated == r.count {
+				return occurrences, nil
+			}
+		}
+	}
+	return occurrences, nil
+}
+
+func (r rrule) periodStart(dtStart time.Time, period int) time.Time {
+	y, m, d := dtStart.Date()
+	h, mi, s := dtStart.Clock()
+	switch r.freq {
+	case freqWeekly:
+		monday := d - (int(dtStart.Weekday())+6)%7
+		return time.Date(y, m, monday+7*r.interval*period, h, mi, s, dtStart.Nanosecond(), dtStart.Location())
+	case freqMonthly:
+		return time.Date(y, m+time.Month(r.interval*period), 1, h, mi, s, dtStart.Nanosecond(), dtStart.Location())
+	default:
+		return time.Date(y, m, d+r.interval*period, h, mi, s, dtStart.Nanosecond(), dtStart.Location())
+	}
+}
+
+func (r rrule) periodCandidates(dtStart time.Time, period int) []time.Time {
+	start := r.periodStart(dtStart, period)
+	candidates := make([]time.Time, 0)
+	switch r.freq {
+	case freqDaily:
+		if r.matchesWeekday(start.Weekday()) {
+			candidates = append(candidates, start)
+		}
+	case freqWeekly:
+		if len(r.byDay) == 0 {
+			candidates = append(candidates, start.AddDate(0, 0, (int(dtStart.Weekday())+6)%7))
+			break
+		}
+		for offset := 0; offset < 7; offset++ {
+			day := start.AddDate(0, 0, offset)
+			if r.matchesWeekday(day.Weekday()) {
+				candidates = append(candidates, day)
+			}
+		}
+	case freqMonthly:
+		candidates = r.monthCandidates(dtStart, start)
+	}
+	return candidates
+}
+
+func (r rrule) matchesWeekday(weekday time.Weekday) bool {
+	if len(r.byDay) == 0 {
+		return true
+	}
+	for _, day := range r.byDay {
+		if day.weekday == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// monthCandidates returns the days of the month the rule selects. As in RFC 5545, BYMONTHDAY and
+// BYDAY together select only the days matching both of them.
+func (r rrule) monthCandidates(dtStart, monthStart time.Time) []time.Time {
+	daysInMonth := monthStart.AddDate(0, 1, -1).Day()
+
+	monthDays := r.byMonthDay
+	if len(monthDays) == 0 && len(r.byDay) == 0 {
+		monthDays = []int{dtStart.Day()}
+	}
+	var byMonthDay map[int]bool
+	if len(monthDays) > 0 {
+		byMonthDay = make(map[int]bool)
+		for _, monthDay := range monthDays {
+			if monthDay < 0 {
+				monthDay = daysInMonth + monthDay + 1
+			}
+			if monthDay >= 1 && monthDay <= daysInMonth {
+				byMonthDay[monthDay] = true
+			}
+		}
+	}
+
+	var byWeekday map[int]bool
+	if len(r.byDay) > 0 {
+		byWeekday = make(map[int]bool)
+		for _, day := range r.byDay {
+			matching := make([]int, 0, 5)
+			for monthDay := 1; monthDay <= daysInMonth; monthDay++ {
+				if monthStart.AddDate(0, 0, monthDay-1).Weekday() == day.weekday {
+					matching = append(matching, monthDay)
+				}
+			}
+			switch {
+			case day.ordinal == 0:
+				for _, monthDay := range matching {
+					byWeekday[monthDay] = true
+				}
+			case day.ordinal > 0 && day.ordinal <= len(matching):
+				byWeekday[matching[day.ordinal-1]] = true
+			case day.ordinal < 0 && -day.ordinal <= len(matching):
+				byWeekday[matching[len(matching)+day.ordinal]] = true
+			}
+		}
+	}
+
+	candidates := make([]time.Time, 0)
+	for monthDay := 1; monthDay <= daysInMonth; monthDay++ {
+		if byMonthDay != nil && !byMonthDay[monthDay] {
+			continue
+		}
+		if byWeekday != nil && !byWeekday[monthDay] {
+			continue
+		}
+		candidates = append(candidates, monthStart.AddDate(0, 0, monthDay-1))
+	}
+	return candidates
+}
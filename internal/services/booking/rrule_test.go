@@ -0,0 +1,135 @@
+package booking
+
+import (
+	"errors"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"testing"
+	"time"
+)
+
+func TestParseRRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    string
+		wantErr bool
+	}{
+		{name: "daily with count", rule: "FREQ=DAILY;COUNT=5"},
+		{name: "prefix and lower case", rule: "RRULE:freq=weekly;byday=mo,we;until=20240401"},
+		{name: "monthly with ordinals", rule: "FREQ=MONTHLY;BYDAY=1MO,-1FR;COUNT=4"},
+		{name: "empty", rule: "", wantErr: true},
+		{name: "no freq", rule: "COUNT=3", wantErr: true},
+		{name: "yearly is not supported", rule: "FREQ=YEARLY;COUNT=3", wantErr: true},
+		{name: "unbounded", rule: "FREQ=DAILY", wantErr: true},
+		{name: "count and until", rule: "FREQ=DAILY;COUNT=3;UNTIL=20240401", wantErr: true},
+		{name: "zero interval", rule: "FREQ=DAILY;INTERVAL=0;COUNT=3", wantErr: true},
+		{name: "ordinal in weekly rule", rule: "FREQ=WEEKLY;BYDAY=1MO;COUNT=3", wantErr: true},
+		{name: "month day in daily rule", rule: "FREQ=DAILY;BYMONTHDAY=1;COUNT=3", wantErr: true},
+		{name: "bad month day", rule: "FREQ=MONTHLY;BYMONTHDAY=32;COUNT=3", wantErr: true},
+		{name: "unknown part", rule: "FREQ=DAILY;COUNT=3;BYHOUR=9", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseRRule(tt.rule)
+			if tt.wantErr {
+				if !errors.Is(err, utills.ErrInvalidRRule) {
+					t.Fatalf("got error %v, want ErrInvalidRRule", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestExpandRRule(t *testing.T) {
+	// 2024-03-11 is a Monday.
+	dtStart := time.Date(2024, time.March, 11, 9, 0, 0, 0, time.UTC)
+	date := func(month time.Month, day int) time.Time {
+		return time.Date(2024, month, day, 9, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name    string
+		rule    string
+		exDates []time.Time
+		want    []time.Time
+		wantErr bool
+	}{
+		{
+			name: "daily every other day",
+			rule: "FREQ=DAILY;INTERVAL=2;COUNT=3",
+			want: []time.Time{date(3, 11), date(3, 13), date(3, 15)},
+		},
+		{
+			name: "daily on weekdays only",
+			rule: "FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR;COUNT=6",
+			want: []time.Time{date(3, 11), date(3, 12), date(3, 13), date(3, 14), date(3, 15), date(3, 18)},
+		},
+		{
+			name: "weekly until a date",
+			rule: "FREQ=WEEKLY;BYDAY=MO,TH;UNTIL=20240321",
+			want: []time.Time{date(3, 11), date(3, 14), date(3, 18), date(3, 21)},
+		},
+		{
+			name:    "count is taken before excluded dates",
+			rule:    "FREQ=WEEKLY;COUNT=3",
+			exDates: []time.Time{time.Date(2024, time.March, 18, 0, 0, 0, 0, time.UTC)},
+			want:    []time.Time{date(3, 11), date(3, 25)},
+		},
+		{
+			name: "monthly on the day of the start",
+			rule: "FREQ=MONTHLY;COUNT=3",
+			want: []time.Time{date(3, 11), date(4, 11), date(5, 11)},
+		},
+		{
+			name: "monthly on the last friday",
+			rule: "FREQ=MONTHLY;BYDAY=-1FR;COUNT=2",
+			want: []time.Time{date(3, 29), date(4, 26)},
+		},
+		{
+			name: "monthly on the last day",
+			rule: "FREQ=MONTHLY;BYMONTHDAY=-1;COUNT=2",
+			want: []time.Time{date(3, 31), date(4, 30)},
+		},
+		{
+			name: "month days and weekdays intersect",
+			rule: "FREQ=MONTHLY;BYDAY=FR;BYMONTHDAY=13,14,15;COUNT=2",
+			want: []time.Time{date(3, 15), date(6, 14)},
+		},
+		{
+			name:    "too many occurrences",
+			rule:    "FREQ=DAILY;COUNT=367",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := parseRRule(tt.rule)
+			if err != nil {
+				t.Fatalf("unexpected parse error: %v", err)
+			}
+			got, err := rule.expand(dtStart, tt.exDates)
+			if tt.wantErr {
+				if !errors.Is(err, utills.ErrInvalidRRule) {
+					t.Fatalf("got error %v, want ErrInvalidRRule", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d occurrences %v, want %d %v", len(got), got, len(tt.want), tt.want)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("occurrence %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
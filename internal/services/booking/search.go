@@ -0,0 +1,171 @@
+package booking
+
+import (
+	"context"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"sort"
+	"time"
+)
+
+// SearchAvailableResources returns resources matching the filter that are free for the whole
+// [startTime, endTime), the best fitting ones first.
+func (b BookingService) SearchAvailableResources(ctx context.Context, bookingType string, startTime, endTime time.Time, filter models.ResourceFilter, page int64) ([]models.Resource, int64, error) {
+	if bookingType == utills.ParkingType {
+		// Место вмещает одну машину, этажей и оборудования у парковки нет
+		filter.Floor = 0
+		filter.Capacity = 0
+		filter.ItemTypes = nil
+	}
+	free, err := b.freeResources(ctx, bookingType, startTime, endTime, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+	rankResources(free)
+	return paginate(free, page), int64(len(free)), nil
+}
+
+// rankResources puts the best fitting resources first: the smallest spare capacity, then the
+// lowest floor and number.
+func rankResources(resources []models.Resource) {
+	sort.SliceStable(resources, func(i, j int) bool {
+		if resources[i].Capacity != resources[j].Capacity {
+			return resources[i].Capacity < resources[j].Capacity
+		}
+		if resources[i].Floor != resources[j].Floor {
+			return resources[i].Floor < resources[j].Floor
+		}
+		return resources[i].Number < resources[j].Number
+	})
+}
+
+// freeResources returns all resources matching the filter that are free for the whole [startTime, endTime).
+func (b BookingService) freeResources(ctx context.Context, bookingType string, startTime, endTime time.Time, filter models.ResourceFilter) ([]models.Resource, error) {
+	resources, err := b.listResources(ctx, bookingType, filter)
+	if err != nil {
+		b.logger.Warnf("Error listing resources: %s", err.Error())
+		return nil, err
+	}
+
+	candidates := make([]models.Resource, 0, len(resources))
+	candidateIds := make([]int64, 0, len(resources))
+	for _, resource := range resources {
+		if matchesFilter(resource, filter) {
+			candidates = append(candidates, resource)
+			candidateIds = append(candidateIds, resource.Id)
+		}
+	}
+	if len(candidates) == 0 {
+		return []models.Resource{}, nil
+	}
+
+	busy, err := b.busyResources(ctx, bookingType, candidates, candidateIds, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	free := make([]models.Resource, 0, len(candidates))
+	for _, resource := range candidates {
+		if !busy[resource.Id] {
+			free = append(free, resource)
+		}
+	}
+	return free, nil
+}
+
+// busyResources tells which of the candidates cannot be booked for [startTime, endTime). A new
+// booking blocks its resource for the range widened by the buffers of the resource, so that range
+// is checked. A pool is busy only when all its places are taken at some moment of the range.
+func (b BookingService) busyResources(ctx context.Context, bookingType string, candidates []models.Resource, candidateIds []int64, startTime, endTime time.Time) (map[int64]bool, error) {
+	buffers, err := b.buffersFor(ctx, bookingType, candidateIds)
+	if err != nil {
+		return nil, err
+	}
+	widest := widestBuffer(buffers)
+	occupancy, err := b.occupancy(ctx, bookingType, candidateIds, startTime.Add(-widest.Before), endTime.Add(widest.After))
+	if err != nil {
+		return nil, err
+	}
+	busy := make(map[int64]bool, len(candidates))
+	for _, resource := range candidates {
+		buffer := buffers[resource.Id]
+		from, to := startTime.Add(-buffer.Before), endTime.Add(buffer.After)
+		if bookingType == utills.PoolType {
+			busy[resource.Id] = len(poolFullPeriods(from, to, occupancy[resource.Id], resource.Capacity)) > 0
+		} else {
+			busy[resource.Id] = overlapsAny(occupancy[resource.Id], from, to)
+		}
+	}
+	return busy, nil
+}
+
+// occupancy returns the blocked ranges of the bookings of every resource overlapping [from, to).
+func (b BookingService) occupancy(ctx context.Context, bookingType string, resourceIds []int64, from, to time.Time) (map[int64][]models.TimeSlot, error) {
+	bookings, err := b.bookingGetter.GetResourcesOccupancy(ctx, bookingType, resourceIds, from, to)
+	if err != nil {
+		b.logger.Warnf("Error getting occupancy: %s", err.Error())
+		return nil, err
+	}
+	occupancy := make(map[int64][]models.TimeSlot, len(resourceIds))
+	for _, booking := range bookings {
+		occupancy[booking.ResourceId] = append(occupancy[booking.ResourceId], models.TimeSlot{StartTime: booking.StartTime, EndTime: booking.EndTime, Busy: true})
+	}
+	return occupancy, nil
+}
+
+func overlapsAny(slots []models.TimeSlot, from, to time.Time) bool {
+	for _, slot := range slots {
+		if slot.StartTime.Before(to) && slot.EndTime.After(from) {
+			return true
+		}
+	}
+	return false
+}
+
+// poolFullPeriods returns the parts of [from, to) where no place of the pool is left, counted
+// the same way as the slots of a pool.
+func poolFullPeriods(from, to time.Time, bookings []models.TimeSlot, capacity int64) []models.TimeSlot {
+	full := make([]models.TimeSlot, 0)
+	for _, slot := range buildPoolSlots(from, to, nil, bookings, capacity, 0) {
+		if slot.RemainingCapacity == 0 {
+			full = append(full, slot)
+		}
+	}
+	return full
+}
+
+func matchesFilter(resource models.Resource, filter models.ResourceFilter) bool {
+	if !resource.IsAvailable || resource.MaintenanceStatus != "" {
+		return false
+	}
+	if resource.Capacity < filter.Capacity {
+		return false
+	}
+	for _, required := range filter.ItemTypes {
+		found := false
+		for _, itemType := range resource.ItemTypes {
+			if itemType == required {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func paginate(resources []models.Resource, page int64) []models.Resource {
+	if page < 1 {
+		page = 1
+	}
+	from := (page - 1) * utills.PageSize
+	if from >= int64(len(resources)) {
+		return []models.Resource{}
+	}
+	to := from + utills.PageSize
+	if to > int64(len(resources)) {
+		to = int64(len(resources))
+	}
+	return resources[from:to]
+}
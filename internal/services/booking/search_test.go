@@ -0,0 +1,195 @@
+package booking
+
+import (
+	"context"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"testing"
+	"time"
+)
+
+func TestMatchesFilter(t *testing.T) {
+	desk := models.Resource{Id: 1, Capacity: 2, IsAvailable: true, ItemTypes: []string{"monitor", "dock"}}
+
+	tests := []struct {
+		name     string
+		resource models.Resource
+		filter   models.ResourceFilter
+		want     bool
+	}{
+		{name: "empty filter", resource: desk, want: true},
+		{name: "enough capacity", resource: desk, filter: models.ResourceFilter{Capacity: 2}, want: true},
+		{name: "too small", resource: desk, filter: models.ResourceFilter{Capacity: 3}, want: false},
+		{name: "all items present", resource: desk, filter: models.ResourceFilter{ItemTypes: []string{"dock", "monitor"}}, want: true},
+		{name: "item missing", resource: desk, filter: models.ResourceFilter{ItemTypes: []string{"monitor", "phone"}}, want: false},
+		{name: "out of service", resource: models.Resource{Id: 2, Capacity: 2}, want: false},
+		{name: "under maintenance", resource: models.Resource{Id: 3, Capacity: 2, IsAvailable: true, MaintenanceStatus: "repair"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchesFilter(tt.resource, tt.filter); got != tt.want {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRankResources(t *testing.T) {
+	resource := func(id, capacity, floor, number int64) models.Resource {
+		return models.Resource{Id: id, Capacity: capacity, Floor: floor, Number: number}
+	}
+	resources := []models.Resource{
+		resource(1, 4, 1, 1),
+		resource(2, 2, 3, 1),
+		resource(3, 2, 1, 7),
+		resource(4, 2, 1, 5),
+		resource(5, 1, 5, 9),
+	}
+	rankResources(resources)
+
+	want := []int64{5, 4, 3, 2, 1}
+	for i, resource := range resources {
+		if resource.Id != want[i] {
+			t.Fatalf("position %d has resource %d, want %d", i, resource.Id, want[i])
+		}
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	resources := make([]models.Resource, utills.PageSize*2+3)
+	for i := range resources {
+		resources[i].Id = int64(i + 1)
+	}
+
+	tests := []struct {
+		name      string
+		page      int64
+		wantLen   int
+		wantFirst int64
+	}{
+		{name: "first page", page: 1, wantLen: utills.PageSize, wantFirst: 1},
+		{name: "page below one is the first", page: 0, wantLen: utills.PageSize, wantFirst: 1},
+		{name: "last page is partial", page: 3, wantLen: 3, wantFirst: utills.PageSize*2 + 1},
+		{name: "beyond the last page", page: 4, wantLen: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := paginate(resources, tt.page)
+			if len(got) != tt.wantLen {
+				t.Fatalf("got %d resources, want %d", len(got), tt.wantLen)
+			}
+			if tt.wantLen > 0 && got[0].Id != tt.wantFirst {
+				t.Fatalf("first resource is %d, want %d", got[0].Id, tt.wantFirst)
+			}
+		})
+	}
+}
+
+func TestPoolFullPeriods(t *testing.T) {
+	start := time.Date(2024, time.March, 11, 9, 0, 0, 0, time.UTC)
+	at := func(hour int) time.Time {
+		return start.Add(time.Duration(hour) * time.Hour)
+	}
+	booking := func(from, to int) models.TimeSlot {
+		return models.TimeSlot{StartTime: at(from), EndTime: at(to), Busy: true}
+	}
+
+	tests := []struct {
+		name     string
+		bookings []models.TimeSlot
+		capacity int64
+		want     []models.TimeSlot
+	}{
+		{name: "one booking leaves places", bookings: []models.TimeSlot{booking(0, 8)}, capacity: 2, want: []models.TimeSlot{}},
+		{name: "full where bookings overlap", bookings: []models.TimeSlot{booking(0, 4), booking(2, 6)}, capacity: 2, want: []models.TimeSlot{booking(2, 4)}},
+		{name: "touching bookings do not overlap", bookings: []models.TimeSlot{booking(0, 4), booking(4, 8)}, capacity: 1, want: []models.TimeSlot{booking(0, 8)}},
+		{name: "empty pool is always full", capacity: 0, want: []models.TimeSlot{booking(0, 8)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := poolFullPeriods(at(0), at(8), tt.bookings, tt.capacity)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d periods %v, want %d %v", len(got), got, len(tt.want), tt.want)
+			}
+			for i := range got {
+				if !got[i].StartTime.Equal(tt.want[i].StartTime) || !got[i].EndTime.Equal(tt.want[i].EndTime) {
+					t.Errorf("period %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+// fakeOccupancy returns the blocked ranges of the bookings it keeps.
+type fakeOccupancy struct {
+	BookingGetter
+	blocked []models.Booking
+}
+
+func (f fakeOccupancy) GetResourcesOccupancy(ctx context.Context, bookingType string, resourceIds []int64, from, to time.Time) ([]models.Booking, error) {
+	bookings := make([]models.Booking, 0)
+	for _, booking := range f.blocked {
+		if booking.StartTime.Before(to) && booking.EndTime.After(from) {
+			bookings = append(bookings, booking)
+		}
+	}
+	return bookings, nil
+}
+
+type fakeBuffers struct {
+	BufferStorage
+	own map[int64]models.Buffer
+}
+
+func (f fakeBuffers) GetResourceBuffer(ctx context.Context, bookingType string, resourceId int64) (models.Buffer, error) {
+	buffer, ok := f.own[resourceId]
+	if !ok {
+		return models.Buffer{}, utills.ErrNoRows
+	}
+	return buffer, nil
+}
+
+func (f fakeBuffers) GetResourceBuffers(ctx context.Context, bookingType string, resourceIds []int64) (map[int64]models.Buffer, error) {
+	return f.own, nil
+}
+
+func TestBusyResources(t *testing.T) {
+	start := time.Date(2024, time.March, 11, 10, 0, 0, 0, time.UTC)
+	end := start.Add(2 * time.Hour)
+	blocked := func(resourceId int64, from, to time.Time) models.Booking {
+		return models.Booking{ResourceId: resourceId, StartTime: from, EndTime: to}
+	}
+	candidates := []models.Resource{{Id: 1}, {Id: 2}, {Id: 3}, {Id: 4}}
+	b := BookingService{
+		logger: testLogger(),
+		bookingGetter: fakeOccupancy{blocked: []models.Booking{
+			// Ends 10 minutes before the range, inside the default before buffer.
+			blocked(1, start.Add(-time.Hour), start.Add(-10*time.Minute)),
+			// Same gap, but the resource has no buffer of its own.
+			blocked(2, start.Add(-time.Hour), start.Add(-10*time.Minute)),
+			// Starts 20 minutes after the range, inside the after buffer of the resource.
+			blocked(3, end.Add(20*time.Minute), end.Add(time.Hour)),
+			// Starts an hour after the range, beyond any buffer.
+			blocked(4, end.Add(time.Hour), end.Add(2*time.Hour)),
+		}},
+		buffers: fakeBuffers{own: map[int64]models.Buffer{
+			2: {},
+			3: {After: 30 * time.Minute},
+		}},
+		defaultBuffers: map[string]models.Buffer{utills.WorkplaceType: {Before: 15 * time.Minute}},
+	}
+
+	busy, err := b.busyResources(context.Background(), utills.WorkplaceType, candidates, []int64{1, 2, 3, 4}, start, end)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[int64]bool{1: true, 2: false, 3: true, 4: false}
+	for id, wantBusy := range want {
+		if busy[id] != wantBusy {
+			t.Errorf("resource %d busy = %v, want %v", id, busy[id], wantBusy)
+		}
+	}
+}
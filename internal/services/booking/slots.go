@@ -0,0 +1,203 @@
+package booking
+
+import (
+	"github.com/pedroxer/booking-service/internal/models"
+	"sort"
+	"time"
+)
+
+// openingHours is the part of a day when resources can be booked, as offsets from midnight.
+type openingHours struct {
+	opensAt  time.Duration
+	closesAt time.Duration
+}
+
+func newOpeningHours(openingHour, closingHour int) openingHours {
+	if closingHour <= 0 || closingHour > 24 {
+		closingHour = 24
+	}
+	if openingHour < 0 || openingHour >= closingHour {
+		openingHour = 0
+	}
+	return openingHours{
+		opensAt:  time.Duration(openingHour) * time.Hour,
+		closesAt: time.Duration(closingHour) * time.Hour,
+	}
+}
+
+// buildSlots splits the day [dayStart, dayEnd) into sorted, non-overlapping busy and free slots
+// that cover it completely. Closed periods of the calendar and free gaps shorter than minSlot
+// cannot be booked, so they are reported as busy, the closed ones with Closed set. A single
+// resource has one unit of capacity, so free slots report RemainingCapacity 1.
+func buildSlots(dayStart, dayEnd time.Time, closed, bookings []models.TimeSlot, minSlot time.Duration) []models.TimeSlot {
+	closed = mergeSlots(clipSlots(closed, dayStart, dayEnd))
+	busy := make([]models.TimeSlot, 0, len(bookings)+len(closed))
+	busy = append(busy, closed...)
+	busy = append(busy, bookings...)
+	busy = mergeSlots(clipSlots(busy, dayStart, dayEnd))
+
+	slots := make([]models.TimeSlot, 0, 2*len(busy)+1)
+	cursor := dayStart
+	for _, slot := range busy {
+		if slot.StartTime.After(cursor) {
+			slots = appendSlot(slots, models.TimeSlot{StartTime: cursor, EndTime: slot.StartTime, Busy: slot.StartTime.Sub(cursor) < minSlot})
+		}
+		slots = appendSlot(slots, slot)
+		cursor = slot.EndTime
+	}
+	if dayEnd.After(cursor) {
+		slots = appendSlot(slots, models.TimeSlot{StartTime: cursor, EndTime: dayEnd, Busy: dayEnd.Sub(cursor) < minSlot})
+	}
+	slots = markClosed(slots, closed)
+	for i := range slots {
+		if !slots[i].Busy {
+			slots[i].RemainingCapacity = 1
+		}
+	}
+	return slots
+}
+
+// markClosed splits busy slots at the borders of the sorted closed periods and marks the closed parts.
+func markClosed(slots, closed []models.TimeSlot) []models.TimeSlot {
+	marked := make([]models.TimeSlot, 0, len(slots)+2*len(closed))
+	for _, slot := range slots {
+		if !slot.Busy {
+			marked = appendSlot(marked, slot)
+			continue
+		}
+		cursor := slot.StartTime
+		for _, period := range closed {
+			if !period.EndTime.After(cursor) || !period.StartTime.Before(slot.EndTime) {
+				continue
+			}
+			if period.StartTime.After(cursor) {
+				marked = appendSlot(marked, models.TimeSlot{StartTime: cursor, EndTime: period.StartTime, Busy: true})
+				cursor = period.StartTime
+			}
+			end := period.EndTime
+			if end.After(slot.EndTime) {
+				end = slot.EndTime
+			}
+			marked = appendSlot(marked, models.TimeSlot{StartTime: cursor, EndTime: end, Busy: true, Closed: true})
+			cursor = end
+		}
+		if slot.EndTime.After(cursor) {
+			marked = appendSlot(marked, models.TimeSlot{StartTime: cursor, EndTime: slot.EndTime, Busy: true})
+		}
+	}
+	return marked
+}
+
+// buildPoolSlots is buildSlots for a pool of capacity places: the day is split at every booking
+// boundary and each slot reports how many places are still free in it. A slot is busy when
+// nothing is left. Runs of free slots shorter than minSlot cannot be booked and are busy too.
+func buildPoolSlots(dayStart, dayEnd time.Time, closed, bookings []models.TimeSlot, capacity int64, minSlot time.Duration) []models.TimeSlot {
+	bookings = clipSlots(bookings, dayStart, dayEnd)
+	closed = mergeSlots(clipSlots(closed, dayStart, dayEnd))
+	bounds := []time.Time{dayStart, dayEnd}
+	for _, slot := range append(closed, bookings...) {
+		bounds = append(bounds, slot.StartTime, slot.EndTime)
+	}
+	sort.Slice(bounds, func(i, j int) bool {
+		return bounds[i].Before(bounds[j])
+	})
+
+	slots := make([]models.TimeSlot, 0, len(bounds))
+	for i := 1; i < len(bounds); i++ {
+		from, to := bounds[i-1], bounds[i]
+		if !to.After(from) {
+			continue
+		}
+		slot := models.TimeSlot{StartTime: from, EndTime: to, RemainingCapacity: capacity}
+		for _, period := range closed {
+			if !from.Before(period.StartTime) && !to.After(period.EndTime) {
+				slot.Closed = true
+				slot.RemainingCapacity = 0
+			}
+		}
+		if !slot.Closed {
+			for _, booking := range bookings {
+				if booking.StartTime.Before(to) && booking.EndTime.After(from) {
+					slot.RemainingCapacity--
+				}
+			}
+			slot.RemainingCapacity = max(slot.RemainingCapacity, 0)
+		}
+		slots = appendPoolSlot(slots, slot)
+	}
+
+	for start := 0; start < len(slots); {
+		end := start
+		for end < len(slots) && slots[end].RemainingCapacity > 0 {
+			end++
+		}
+		if end > start && slots[end-1].EndTime.Sub(slots[start].StartTime) < minSlot {
+			for i := start; i < end; i++ {
+				slots[i].RemainingCapacity = 0
+			}
+		}
+		start = end + 1
+	}
+
+	merged := make([]models.TimeSlot, 0, len(slots))
+	for _, slot := range slots {
+		slot.Busy = slot.RemainingCapacity == 0
+		merged = appendPoolSlot(merged, slot)
+	}
+	return merged
+}
+
+// clipSlots cuts slots to [from, to) and drops the ones left empty.
+func clipSlots(slots []models.TimeSlot, from, to time.Time) []models.TimeSlot {
+	clipped := make([]models.TimeSlot, 0, len(slots))
+	for _, slot := range slots {
+		if slot.StartTime.Before(from) {
+			slot.StartTime = from
+		}
+		if slot.EndTime.After(to) {
+			slot.EndTime = to
+		}
+		if slot.EndTime.After(slot.StartTime) {
+			slot.Busy = true
+			clipped = append(clipped, slot)
+		}
+	}
+	return clipped
+}
+
+// mergeSlots sorts busy slots and joins the overlapping and touching ones.
+func mergeSlots(slots []models.TimeSlot) []models.TimeSlot {
+	sort.Slice(slots, func(i, j int) bool {
+		return slots[i].StartTime.Before(slots[j].StartTime)
+	})
+	merged := make([]models.TimeSlot, 0, len(slots))
+	for _, slot := range slots {
+		last := len(merged) - 1
+		if last >= 0 && !slot.StartTime.After(merged[last].EndTime) {
+			if slot.EndTime.After(merged[last].EndTime) {
+				merged[last].EndTime = slot.EndTime
+			}
+			continue
+		}
+		merged = append(merged, slot)
+	}
+	return merged
+}
+
+// appendSlot adds the slot to the partition, extending the last slot if both have the same state.
+func appendSlot(slots []models.TimeSlot, slot models.TimeSlot) []models.TimeSlot {
+	if last := len(slots) - 1; last >= 0 && slots[last].Busy == slot.Busy && slots[last].Closed == slot.Closed && slots[last].EndTime.Equal(slot.StartTime) {
+		slots[last].EndTime = slot.EndTime
+		return slots
+	}
+	return append(slots, slot)
+}
+
+// appendPoolSlot is appendSlot for pools, slots are joined when they have the same remaining capacity.
+func appendPoolSlot(slots []models.TimeSlot, slot models.TimeSlot) []models.TimeSlot {
+	if last := len(slots) - 1; last >= 0 && slots[last].RemainingCapacity == slot.RemainingCapacity && slots[last].Closed == slot.Closed && slots[last].EndTime.Equal(slot.StartTime) {
+		slots[last].EndTime = slot.EndTime
+		return slots
+	}
+	return append(slots, slot)
+}
@@ -0,0 +1,274 @@
+package booking
+
+import (
+	"github.com/pedroxer/booking-service/internal/models"
+	"testing"
+	"time"
+)
+
+func TestBuildSlots(t *testing.T) {
+	dayStart := time.Date(2024, time.March, 12, 0, 0, 0, 0, time.UTC)
+	dayEnd := dayStart.AddDate(0, 0, 1)
+	at := func(hour, minute int) time.Time {
+		return dayStart.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+	}
+	busy := func(from, to time.Time) models.TimeSlot {
+		return models.TimeSlot{StartTime: from, EndTime: to, Busy: true}
+	}
+	free := func(from, to time.Time) models.TimeSlot {
+		return models.TimeSlot{StartTime: from, EndTime: to}
+	}
+	closed := func(from, to time.Time) models.TimeSlot {
+		return models.TimeSlot{StartTime: from, EndTime: to, Busy: true, Closed: true}
+	}
+	allDay := newOpeningHours(0, 0)
+	office := newOpeningHours(8, 21)
+
+	tests := []struct {
+		name     string
+		hours    openingHours
+		minSlot  time.Duration
+		bookings []models.TimeSlot
+		want     []models.TimeSlot
+	}{
+		{
+			name:  "empty day",
+			hours: allDay,
+			want:  []models.TimeSlot{free(dayStart, dayEnd)},
+		},
+		{
+			name:  "closed outside opening hours",
+			hours: office,
+			want:  []models.TimeSlot{closed(dayStart, at(8, 0)), free(at(8, 0), at(21, 0)), closed(at(21, 0), dayEnd)},
+		},
+		{
+			name:     "free time before first and after last booking",
+			hours:    allDay,
+			bookings: []models.TimeSlot{busy(at(10, 0), at(12, 0))},
+			want:     []models.TimeSlot{free(dayStart, at(10, 0)), busy(at(10, 0), at(12, 0)), free(at(12, 0), dayEnd)},
+		},
+		{
+			name:     "booking from previous day",
+			hours:    office,
+			bookings: []models.TimeSlot{busy(at(-2, 0), at(9, 0))},
+			want:     []models.TimeSlot{closed(dayStart, at(8, 0)), busy(at(8, 0), at(9, 0)), free(at(9, 0), at(21, 0)), closed(at(21, 0), dayEnd)},
+		},
+		{
+			name:     "booking into next day",
+			hours:    allDay,
+			bookings: []models.TimeSlot{busy(at(22, 0), at(26, 0))},
+			want:     []models.TimeSlot{free(dayStart, at(22, 0)), busy(at(22, 0), dayEnd)},
+		},
+		{
+			name:     "overlapping bookings are merged",
+			hours:    allDay,
+			bookings: []models.TimeSlot{busy(at(10, 0), at(12, 0)), busy(at(11, 0), at(13, 0))},
+			want:     []models.TimeSlot{free(dayStart, at(10, 0)), busy(at(10, 0), at(13, 0)), free(at(13, 0), dayEnd)},
+		},
+		{
+			name:     "adjacent bookings are merged",
+			hours:    allDay,
+			bookings: []models.TimeSlot{busy(at(10, 0), at(11, 0)), busy(at(11, 0), at(12, 0))},
+			want:     []models.TimeSlot{free(dayStart, at(10, 0)), busy(at(10, 0), at(12, 0)), free(at(12, 0), dayEnd)},
+		},
+		{
+			name:     "nested booking",
+			hours:    allDay,
+			bookings: []models.TimeSlot{busy(at(10, 0), at(14, 0)), busy(at(11, 0), at(12, 0))},
+			want:     []models.TimeSlot{free(dayStart, at(10, 0)), busy(at(10, 0), at(14, 0)), free(at(14, 0), dayEnd)},
+		},
+		{
+			name:     "unsorted bookings",
+			hours:    allDay,
+			bookings: []models.TimeSlot{busy(at(15, 0), at(16, 0)), busy(at(9, 0), at(10, 0))},
+			want: []models.TimeSlot{free(dayStart, at(9, 0)), busy(at(9, 0), at(10, 0)), free(at(10, 0), at(15, 0)),
+				busy(at(15, 0), at(16, 0)), free(at(16, 0), dayEnd)},
+		},
+		{
+			name:     "gap shorter than minimum slot is busy",
+			hours:    office,
+			minSlot:  30 * time.Minute,
+			bookings: []models.TimeSlot{busy(at(10, 0), at(11, 0)), busy(at(11, 15), at(12, 0))},
+			want:     []models.TimeSlot{closed(dayStart, at(8, 0)), free(at(8, 0), at(10, 0)), busy(at(10, 0), at(12, 0)), free(at(12, 0), at(21, 0)), closed(at(21, 0), dayEnd)},
+		},
+		{
+			name:     "gap equal to minimum slot is free",
+			hours:    allDay,
+			minSlot:  30 * time.Minute,
+			bookings: []models.TimeSlot{busy(at(10, 0), at(11, 0)), busy(at(11, 30), at(12, 0))},
+			want: []models.TimeSlot{free(dayStart, at(10, 0)), busy(at(10, 0), at(11, 0)), free(at(11, 0), at(11, 30)),
+				busy(at(11, 30), at(12, 0)), free(at(12, 0), dayEnd)},
+		},
+		{
+			name:     "short opening hours remainder is busy",
+			hours:    office,
+			minSlot:  30 * time.Minute,
+			bookings: []models.TimeSlot{busy(at(8, 10), at(20, 45))},
+			want:     []models.TimeSlot{closed(dayStart, at(8, 0)), busy(at(8, 0), at(21, 0)), closed(at(21, 0), dayEnd)},
+		},
+		{
+			name:     "booking touching opening time",
+			hours:    office,
+			bookings: []models.TimeSlot{busy(at(7, 0), at(8, 0))},
+			want:     []models.TimeSlot{closed(dayStart, at(8, 0)), free(at(8, 0), at(21, 0)), closed(at(21, 0), dayEnd)},
+		},
+		{
+			name:     "booking outside the day is ignored",
+			hours:    allDay,
+			bookings: []models.TimeSlot{busy(at(-5, 0), at(-1, 0))},
+			want:     []models.TimeSlot{free(dayStart, dayEnd)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			closedPeriods := calendar{defaults: tt.hours}.closedPeriods(dayStart, dayEnd)
+			got := buildSlots(dayStart, dayEnd, closedPeriods, tt.bookings, tt.minSlot)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d slots %v, want %d %v", len(got), got, len(tt.want), tt.want)
+			}
+			for i := range got {
+				if !got[i].StartTime.Equal(tt.want[i].StartTime) || !got[i].EndTime.Equal(tt.want[i].EndTime) ||
+					got[i].Busy != tt.want[i].Busy || got[i].Closed != tt.want[i].Closed {
+					t.Errorf("slot %d = %v, want %v", i, got[i], tt.want[i])
+				}
+				if !got[i].Busy && got[i].RemainingCapacity != 1 {
+					t.Errorf("free slot %d remaining capacity = %d, want 1", i, got[i].RemainingCapacity)
+				}
+			}
+			checkPartition(t, got, dayStart, dayEnd)
+		})
+	}
+}
+
+func checkPartition(t *testing.T, slots []models.TimeSlot, dayStart, dayEnd time.Time) {
+	t.Helper()
+	cursor := dayStart
+	for i, slot := range slots {
+		if !slot.StartTime.Equal(cursor) {
+			t.Errorf("slot %d starts at %v, want %v", i, slot.StartTime, cursor)
+		}
+		if !slot.EndTime.After(slot.StartTime) {
+			t.Errorf("slot %d is empty", i)
+		}
+		if i > 0 && slots[i-1].Busy == slot.Busy && slots[i-1].Closed == slot.Closed {
+			t.Errorf("slots %d and %d have the same state and should be merged", i-1, i)
+		}
+		cursor = slot.EndTime
+	}
+	if !cursor.Equal(dayEnd) {
+		t.Errorf("slots end at %v, want %v", cursor, dayEnd)
+	}
+}
+
+func TestBuildPoolSlots(t *testing.T) {
+	dayStart := time.Date(2024, time.March, 12, 0, 0, 0, 0, time.UTC)
+	dayEnd := dayStart.AddDate(0, 0, 1)
+	at := func(hour, minute int) time.Time {
+		return dayStart.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+	}
+	booking := func(from, to time.Time) models.TimeSlot {
+		return models.TimeSlot{StartTime: from, EndTime: to, Busy: true}
+	}
+	left := func(from, to time.Time, remaining int64) models.TimeSlot {
+		return models.TimeSlot{StartTime: from, EndTime: to, Busy: remaining == 0, RemainingCapacity: remaining}
+	}
+	closed := func(from, to time.Time) models.TimeSlot {
+		return models.TimeSlot{StartTime: from, EndTime: to, Busy: true, Closed: true}
+	}
+	allDay := newOpeningHours(0, 0)
+	office := newOpeningHours(8, 21)
+
+	tests := []struct {
+		name     string
+		hours    openingHours
+		capacity int64
+		minSlot  time.Duration
+		bookings []models.TimeSlot
+		want     []models.TimeSlot
+	}{
+		{
+			name:     "empty pool",
+			hours:    office,
+			capacity: 3,
+			want:     []models.TimeSlot{closed(dayStart, at(8, 0)), left(at(8, 0), at(21, 0), 3), closed(at(21, 0), dayEnd)},
+		},
+		{
+			name:     "overlapping bookings take one place each",
+			hours:    allDay,
+			capacity: 3,
+			bookings: []models.TimeSlot{booking(at(9, 0), at(12, 0)), booking(at(10, 0), at(11, 0))},
+			want: []models.TimeSlot{left(dayStart, at(9, 0), 3), left(at(9, 0), at(10, 0), 2), left(at(10, 0), at(11, 0), 1),
+				left(at(11, 0), at(12, 0), 2), left(at(12, 0), dayEnd, 3)},
+		},
+		{
+			name:     "full pool is busy",
+			hours:    allDay,
+			capacity: 2,
+			bookings: []models.TimeSlot{booking(at(9, 0), at(12, 0)), booking(at(10, 0), at(11, 0))},
+			want: []models.TimeSlot{left(dayStart, at(9, 0), 2), left(at(9, 0), at(10, 0), 1), left(at(10, 0), at(11, 0), 0),
+				left(at(11, 0), at(12, 0), 1), left(at(12, 0), dayEnd, 2)},
+		},
+		{
+			name:     "back to back bookings share a place",
+			hours:    allDay,
+			capacity: 1,
+			bookings: []models.TimeSlot{booking(at(9, 0), at(10, 0)), booking(at(10, 0), at(11, 0))},
+			want:     []models.TimeSlot{left(dayStart, at(9, 0), 1), left(at(9, 0), at(11, 0), 0), left(at(11, 0), dayEnd, 1)},
+		},
+		{
+			name:     "short free run is busy",
+			hours:    allDay,
+			capacity: 1,
+			minSlot:  30 * time.Minute,
+			bookings: []models.TimeSlot{booking(at(9, 0), at(10, 0)), booking(at(10, 15), at(11, 0))},
+			want:     []models.TimeSlot{left(dayStart, at(9, 0), 1), left(at(9, 0), at(11, 0), 0), left(at(11, 0), dayEnd, 1)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			closedPeriods := calendar{defaults: tt.hours}.closedPeriods(dayStart, dayEnd)
+			got := buildPoolSlots(dayStart, dayEnd, closedPeriods, tt.bookings, tt.capacity, tt.minSlot)
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d slots %v, want %d %v", len(got), got, len(tt.want), tt.want)
+			}
+			for i := range got {
+				if !got[i].StartTime.Equal(tt.want[i].StartTime) || !got[i].EndTime.Equal(tt.want[i].EndTime) ||
+					got[i].Busy != tt.want[i].Busy || got[i].Closed != tt.want[i].Closed || got[i].RemainingCapacity != tt.want[i].RemainingCapacity {
+					t.Errorf("slot %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestBuildSlotsWithBuffers(t *testing.T) {
+	dayStart := time.Date(2024, time.March, 12, 0, 0, 0, 0, time.UTC)
+	dayEnd := dayStart.AddDate(0, 0, 1)
+	at := func(hour, minute int) time.Time {
+		return dayStart.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+	}
+	// Бронь 10:00-12:00 с уборкой 15 минут после неё, у ресурса такой же буфер для новой брони
+	buffer := models.Buffer{After: 15 * time.Minute}
+	booking := withBuffer(models.Booking{StartTime: at(10, 0), EndTime: at(12, 0)}, buffer)
+	blocked := []models.TimeSlot{{StartTime: booking.BlockedStart, EndTime: booking.BlockedEnd}}
+
+	got := buildSlots(dayStart, dayEnd, nil, unbookableRanges(blocked, buffer), 0)
+	want := []models.TimeSlot{
+		{StartTime: dayStart, EndTime: at(9, 45)},
+		{StartTime: at(9, 45), EndTime: at(12, 15), Busy: true},
+		{StartTime: at(12, 15), EndTime: dayEnd},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range got {
+		if !got[i].StartTime.Equal(want[i].StartTime) || !got[i].EndTime.Equal(want[i].EndTime) || got[i].Busy != want[i].Busy {
+			t.Errorf("slot %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+	if !booking.StartTime.Equal(at(10, 0)) || !booking.EndTime.Equal(at(12, 0)) {
+		t.Errorf("buffers changed visible times of the booking: %v - %v", booking.StartTime, booking.EndTime)
+	}
+}
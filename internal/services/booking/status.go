@@ -0,0 +1,64 @@
+package booking
+
+import (
+	"fmt"
+	"github.com/pedroxer/booking-service/internal/utills"
+)
+
+// bookingTransitions is the booking lifecycle. DONE, CANCELLED, NO_SHOW and EXPIRED are final.
+var bookingTransitions = map[string][]string{
+	utills.StatusPending:    {utills.StatusConfirmed, utills.StatusCancelled, utills.StatusNoShow, utills.StatusExpired},
+	utills.StatusConfirmed: {utills.StatusDone},
+}
+
+// flowStatuses maps the statuses only a dedicated flow moves a booking to onto that flow, as it
+// records more than the status: who cancelled, when the user arrived or left, which slot frees up.
+var flowStatuses = map[string]string{
+	utills.StatusConfirmed: "ApproveBooking",
+	utills.StatusDone:      "CheckOutBooking",
+	utills.StatusCancelled: "CancelBooking",
+	utills.StatusNoShow:    "the no-show release",
+	utills.StatusExpired:   "the no-show release",
+}
+
+// transferTransitions is the lifecycle of a transfer offer, only a waiting offer is answered or
+// cancelled.
+var transferTransitions = map[string][]string{
+	utills.TransferPending: {utills.TransferAccepted, utills.TransferDeclined, utills.TransferCancelled},
+}
+
+func checkTransition(from, to string) error {
+	return transitionIn(bookingTransitions, from, to)
+}
+
+func checkTransferTransition(from, to string) error {
+	return transitionIn(transferTransitions, from, to)
+}
+
+// checkStatusUpdate refuses the statuses UpdateBooking must not set, they belong to flowStatuses.
+func checkStatusUpdate(status string) error {
+	if flow, ok := flowStatuses[status]; ok {
+		return fmt.Errorf("%w: bookings become %s with %s", utills.ErrInvalidTransition, status, flow)
+	}
+	return nil
+}
+
+func transitionIn(transitions map[string][]string, from, to string) error {
+	for _, next := range transitions[from] {
+		if next == to {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s -> %s", utills.ErrInvalidTransition, from, to)
+}
+
+// statusesAllowing returns the statuses a booking can be moved to the given status from.
+func statusesAllowing(to string) []string {
+	statuses := make([]string, 0)
+	for from := range bookingTransitions {
+		if checkTransition(from, to) == nil {
+			statuses = append(statuses, from)
+		}
+	}
+	return statuses
+}
@@ -0,0 +1,105 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestCheckTransition(t *testing.T) {
+	allowed := map[string][]string{
+		utills.StatusPending:   {utills.StatusConfirmed, utills.StatusCancelled, utills.StatusNoShow, utills.StatusExpired},
+		utills.StatusConfirmed: {utills.StatusDone},
+	}
+	statuses := []string{
+		utills.StatusPending,
+		utills.StatusConfirmed,
+		utills.StatusDone,
+		utills.StatusCancelled,
+		utills.StatusNoShow,
+		utills.StatusExpired,
+	}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			t.Run(from+"->"+to, func(t *testing.T) {
+				err := checkTransition(from, to)
+				if slices.Contains(allowed[from], to) {
+					if err != nil {
+						t.Fatalf("transition is forbidden: %v", err)
+					}
+					return
+				}
+				if !errors.Is(err, utills.ErrInvalidTransition) {
+					t.Fatalf("got error %v, want ErrInvalidTransition", err)
+				}
+			})
+		}
+	}
+}
+
+func TestCheckTransferTransition(t *testing.T) {
+	statuses := []string{utills.TransferPending, utills.TransferAccepted, utills.TransferDeclined, utills.TransferCancelled}
+
+	for _, from := range statuses {
+		for _, to := range statuses {
+			t.Run(from+"->"+to, func(t *testing.T) {
+				err := checkTransferTransition(from, to)
+				if from == utills.TransferPending && to != utills.TransferPending {
+					if err != nil {
+						t.Fatalf("transition is forbidden: %v", err)
+					}
+					return
+				}
+				if !errors.Is(err, utills.ErrInvalidTransition) {
+					t.Fatalf("got error %v, want ErrInvalidTransition", err)
+				}
+			})
+		}
+	}
+}
+
+func TestStatusesAllowing(t *testing.T) {
+	tests := []struct {
+		to   string
+		want []string
+	}{
+		{to: utills.StatusCancelled, want: []string{utills.StatusPending}},
+		{to: utills.StatusDone, want: []string{utills.StatusConfirmed}},
+		{to: utills.StatusPending, want: []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.to, func(t *testing.T) {
+			got := statusesAllowing(tt.to)
+			slices.Sort(got)
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUpdateBookingFlowStatuses(t *testing.T) {
+	statuses := []string{
+		utills.StatusConfirmed,
+		utills.StatusDone,
+		utills.StatusCancelled,
+		utills.StatusNoShow,
+		utills.StatusExpired,
+	}
+
+	for _, status := range statuses {
+		t.Run(status, func(t *testing.T) {
+			// The refused status never reaches storage, the fake updater would panic on it.
+			b := transferService(nil, nil, utills.TransferPending)
+			_, err := b.UpdateBooking(context.Background(), utills.WorkplaceType, status, 1, time.Time{}, time.Time{}, "", "alice")
+			if !errors.Is(err, utills.ErrInvalidTransition) {
+				t.Fatalf("got error %v, want %v", err, utills.ErrInvalidTransition)
+			}
+		})
+	}
+}
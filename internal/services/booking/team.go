@@ -0,0 +1,145 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"sort"
+	"time"
+)
+
+// Reasons a team member was left without a desk, policy violations keep their own reasons.
+const (
+	reasonNoFreeDesk     = "NO_FREE_DESK"
+	reasonNotDelegate    = "NOT_DELEGATE"
+	reasonResourceClosed = "RESOURCE_CLOSED"
+)
+
+// CreateTeamBooking seats a team together for [startTime, endTime). It picks the floor of a zone
+// where most members fit on desks with the closest numbers and books all of them in one
+// transaction. Members the organiser may not book for, who break a policy or who did not fit are
+// returned as unseated, the desk of a member who breaks a policy goes to the next one.
+func (b BookingService) CreateTeamBooking(ctx context.Context, bookedBy string, userIds []string, startTime, endTime time.Time, filter models.ResourceFilter) (models.TeamBooking, error) {
+	team := models.TeamBooking{Bookings: []models.Booking{}, Unseated: []models.UnseatedMember{}}
+	seen := make(map[string]bool, len(userIds))
+	members := make([]string, 0, len(userIds))
+	for _, userId := range userIds {
+		if userId == "" || seen[userId] {
+			continue
+		}
+		seen[userId] = true
+		if err := b.checkActsFor(ctx, utills.WorkplaceType, bookedBy, userId); err != nil {
+			if !errors.Is(err, utills.ErrNotDelegate) {
+				return models.TeamBooking{}, err
+			}
+			team.Unseated = append(team.Unseated, models.UnseatedMember{UserId: userId, Reason: reasonNotDelegate})
+			continue
+		}
+		members = append(members, userId)
+	}
+
+	free, err := b.freeResources(ctx, utills.WorkplaceType, startTime, endTime, filter)
+	if err != nil {
+		return models.TeamBooking{}, err
+	}
+	desks := pickNeighbourhood(free, len(members))
+
+	bookings := make([]models.Booking, 0, len(desks))
+	next := 0
+	for _, userId := range members {
+		reason := reasonNoFreeDesk
+		for next < len(desks) {
+			booking, err := b.prepareBooking(ctx, utills.WorkplaceType, models.Booking{
+				UserId:     userId,
+				ResourceId: desks[next].Id,
+				StartTime:  startTime,
+				EndTime:    endTime,
+				Status:     utills.StatusPending,
+				BookedBy:   bookedBy,
+			})
+			var violation *utills.PolicyViolationError
+			switch {
+			case errors.As(err, &violation):
+				// Политика относится к участнику, его место достаётся следующему
+				reason = violation.Reason
+			case errors.Is(err, utills.ErrResourceClosed):
+				// Закрыто само место, участник пробует следующее
+				reason = reasonResourceClosed
+				next++
+				continue
+			case err != nil:
+				return models.TeamBooking{}, err
+			default:
+				bookings = append(bookings, booking)
+				reason = ""
+				next++
+			}
+			break
+		}
+		if reason != "" {
+			team.Unseated = append(team.Unseated, models.UnseatedMember{UserId: userId, Reason: reason})
+		}
+	}
+	if len(bookings) == 0 {
+		return team, nil
+	}
+
+	created, err := b.bookingCreater.CreateTeamBooking(ctx, utills.WorkplaceType, bookings, b.checkQuota)
+	if err != nil {
+		b.logger.Warnf("Error creating team booking: %s", err.Error())
+		return models.TeamBooking{}, err
+	}
+	team.Bookings = created
+	return team, nil
+}
+
+// floorOf is a floor of one zone, floors of different zones are different places.
+type floorOf struct {
+	zone  string
+	floor int64
+}
+
+// pickNeighbourhood picks up to n desks on one floor of a zone whose numbers are the closest. The
+// floor seating the most people wins, then the one where they sit closest, then the lowest floor
+// and the first zone by name.
+func pickNeighbourhood(free []models.Resource, n int) []models.Resource {
+	if n <= 0 {
+		return nil
+	}
+	floors := make(map[floorOf][]models.Resource)
+	for _, desk := range free {
+		key := floorOf{zone: desk.Zone, floor: desk.Floor}
+		floors[key] = append(floors[key], desk)
+	}
+
+	var best []models.Resource
+	var bestFloor floorOf
+	var bestSpan int64
+	for floor, desks := range floors {
+		sort.SliceStable(desks, func(i, j int) bool {
+			return desks[i].Number < desks[j].Number
+		})
+		size := min(n, len(desks))
+		start := 0
+		for i := 1; i+size <= len(desks); i++ {
+			if desks[i+size-1].Number-desks[i].Number < desks[start+size-1].Number-desks[start].Number {
+				start = i
+			}
+		}
+		window := desks[start : start+size]
+		span := window[size-1].Number - window[0].Number
+		if best == nil || size > len(best) ||
+			size == len(best) && (span < bestSpan || span == bestSpan && floor.before(bestFloor)) {
+			best, bestFloor, bestSpan = window, floor, span
+		}
+	}
+	return best
+}
+
+func (f floorOf) before(other floorOf) bool {
+	if f.floor != other.floor {
+		return f.floor < other.floor
+	}
+	return f.zone < other.zone
+}
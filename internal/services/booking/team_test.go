@@ -0,0 +1,175 @@
+package booking
+
+import (
+	"context"
+	"github.com/pedroxer/booking-service/internal/models"
+	proto_gen "github.com/pedroxer/booking-service/internal/proto_gen/protos"
+	"github.com/pedroxer/booking-service/internal/storage"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestPickNeighbourhood(t *testing.T) {
+	desk := func(id, floor, number int64) models.Resource {
+		return models.Resource{Id: id, Floor: floor, Number: number}
+	}
+	zoneDesk := func(id int64, zone string, floor, number int64) models.Resource {
+		return models.Resource{Id: id, Zone: zone, Floor: floor, Number: number}
+	}
+
+	tests := []struct {
+		name string
+		free []models.Resource
+		n    int
+		want []int64
+	}{
+		{
+			name: "nobody to seat",
+			free: []models.Resource{desk(1, 1, 1)},
+			n:    0,
+			want: nil,
+		},
+		{
+			name: "no free desks",
+			n:    2,
+			want: nil,
+		},
+		{
+			name: "closest numbers win",
+			free: []models.Resource{desk(1, 1, 1), desk(2, 1, 5), desk(3, 1, 6), desk(4, 1, 7), desk(5, 1, 12)},
+			n:    3,
+			want: []int64{2, 3, 4},
+		},
+		{
+			name: "desks are ordered by number",
+			free: []models.Resource{desk(3, 2, 9), desk(1, 2, 3), desk(2, 2, 4)},
+			n:    2,
+			want: []int64{1, 2},
+		},
+		{
+			name: "floor seating everybody wins over a tighter one",
+			free: []models.Resource{desk(1, 1, 1), desk(2, 1, 2), desk(3, 2, 1), desk(4, 2, 10), desk(5, 2, 20)},
+			n:    3,
+			want: []int64{3, 4, 5},
+		},
+		{
+			name: "tighter floor wins",
+			free: []models.Resource{desk(1, 1, 1), desk(2, 1, 9), desk(3, 3, 4), desk(4, 3, 5)},
+			n:    2,
+			want: []int64{3, 4},
+		},
+		{
+			name: "lower floor wins a tie",
+			free: []models.Resource{desk(1, 4, 1), desk(2, 4, 2), desk(3, 2, 7), desk(4, 2, 8)},
+			n:    2,
+			want: []int64{3, 4},
+		},
+		{
+			name: "same floor of another zone is another place",
+			free: []models.Resource{zoneDesk(1, "A", 2, 1), zoneDesk(2, "B", 2, 2), zoneDesk(3, "B", 2, 3), zoneDesk(4, "A", 2, 4)},
+			n:    3,
+			want: []int64{2, 3},
+		},
+		{
+			name: "first zone by name wins a tie",
+			free: []models.Resource{zoneDesk(1, "B", 1, 1), zoneDesk(2, "B", 1, 2), zoneDesk(3, "A", 1, 5), zoneDesk(4, "A", 1, 6)},
+			n:    2,
+			want: []int64{3, 4},
+		},
+		{
+			name: "not enough desks seats as many as possible",
+			free: []models.Resource{desk(1, 1, 1), desk(2, 2, 1), desk(3, 2, 2)},
+			n:    4,
+			want: []int64{2, 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int64
+			for _, picked := range pickNeighbourhood(tt.free, tt.n) {
+				got = append(got, picked.Id)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("pickNeighbourhood() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// fakeGroups keeps the groups of every user.
+type fakeGroups struct {
+	fakePolicies
+	userGroups map[string][]string
+}
+
+func (f fakeGroups) GetUserGroups(ctx context.Context, userId string) ([]string, error) {
+	return f.userGroups[userId], nil
+}
+
+// fakeBlackouts closes the resources it keeps for any time.
+type fakeBlackouts struct {
+	fakeCalendars
+	closed map[int64]bool
+}
+
+func (f fakeBlackouts) GetBlackouts(ctx context.Context, bookingType string, resourceId int64, from, to time.Time) ([]models.Blackout, error) {
+	if !f.closed[resourceId] {
+		return nil, nil
+	}
+	return []models.Blackout{{ResourceId: resourceId, StartTime: from, EndTime: to}}, nil
+}
+
+func (f fakeCreated) CreateTeamBooking(ctx context.Context, bookingType string, bookings []models.Booking, check storage.QuotaCheck) ([]models.Booking, error) {
+	*f.created = append(*f.created, bookings...)
+	return bookings, nil
+}
+
+func TestCreateTeamBooking(t *testing.T) {
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	workplace := func(id int64, zone string, number int64) *proto_gen.Workplace {
+		return &proto_gen.Workplace{Id: id, Zone: zone, Floor: 1, Number: number, Capacity: 1, IsAvailable: true}
+	}
+	created := make([]models.Booking, 0)
+	b := transferService(nil, nil, utills.TransferPending)
+	b.resourceClient = fakeResources{workplaces: []*proto_gen.Workplace{
+		workplace(1, "A", 1), workplace(2, "A", 2), workplace(3, "A", 3), workplace(9, "B", 4),
+	}}
+	b.bookingCreater = fakeCreated{created: &created}
+	b.policies = fakeGroups{
+		fakePolicies: fakePolicies{policy: models.Policy{ZoneGroups: map[string][]string{"A": {"team"}, "B": {"team"}}}},
+		userGroups:   map[string][]string{"alice": {"team"}, "carol": {"team"}, "erin": {"team"}, "dave": {"team"}},
+	}
+	b.calendars = fakeBlackouts{closed: map[int64]bool{2: true}}
+	b.delegations = fakeDelegations{principals: map[string][]string{"alice": {"bob", "carol", "erin"}}}
+
+	// bob is not allowed into the zone and leaves desk 2 to carol, desk 2 is closed, so carol
+	// sits at desk 3 and nothing is left for erin: desk 9 is in another zone.
+	team, err := b.CreateTeamBooking(context.Background(), "alice", []string{"alice", "bob", "dave", "carol", "erin"},
+		start, start.Add(8*time.Hour), models.ResourceFilter{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	seated := make([]string, 0)
+	for _, booking := range team.Bookings {
+		seated = append(seated, booking.UserId+"@"+strconv.FormatInt(booking.ResourceId, 10))
+		if booking.UserId != "alice" && booking.BookedBy != "alice" {
+			t.Errorf("booking of %s is booked by %q, want alice", booking.UserId, booking.BookedBy)
+		}
+	}
+	if want := []string{"alice@1", "carol@3"}; !slices.Equal(seated, want) {
+		t.Errorf("seated %v, want %v", seated, want)
+	}
+	unseated := make([]string, 0)
+	for _, member := range team.Unseated {
+		unseated = append(unseated, member.UserId+":"+member.Reason)
+	}
+	want := []string{"dave:" + reasonNotDelegate, "bob:" + reasonZoneNotAllowed, "erin:" + reasonNoFreeDesk}
+	if !slices.Equal(unseated, want) {
+		t.Errorf("unseated %v, want %v", unseated, want)
+	}
+}
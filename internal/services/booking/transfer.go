@@ -0,0 +1,231 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"time"
+)
+
+// MoveBooking puts an active booking on another resource of the same type, in the same or in
+// another zone. userId is the owner or their delegate. The id and times of the booking stay, the
+// old resource is offered to the waitlist.
+func (b BookingService) MoveBooking(ctx context.Context, bookingType string, bookingId int64, userId string, resourceId int64) (models.Booking, error) {
+	booking, err := b.bookingGetter.GetBookingsById(ctx, bookingType, bookingId)
+	if err != nil {
+		b.logger.Warnf("Error getting booking: %s", err.Error())
+		return models.Booking{}, err
+	}
+	if err := b.checkOwner(ctx, bookingType, userId, booking.UserId); err != nil {
+		b.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	if booking.ResourceId == resourceId {
+		return booking, nil
+	}
+	previous, err := b.getResource(ctx, bookingType, booking.ResourceId)
+	if err != nil {
+		b.logger.Warn("Error getting resource ", err)
+		return models.Booking{}, err
+	}
+	resource, err := b.getResource(ctx, bookingType, resourceId)
+	if err != nil {
+		b.logger.Warn("Error getting resource ", err)
+		return models.Booking{}, err
+	}
+	if !resource.IsAvailable {
+		b.logger.Warn("Resource is not available")
+		return models.Booking{}, utills.ErrResourceUnavailable
+	}
+	if err := b.checkOpen(ctx, bookingType, resource, booking.StartTime, booking.EndTime); err != nil {
+		b.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	if bookingType == utills.RoomType {
+		if _, err := roomAttendees(resource, booking.UserId, booking.Attendees); err != nil {
+			b.logger.Warn(err)
+			return models.Booking{}, err
+		}
+	}
+	if resource.Zone != previous.Zone {
+		policy, err := b.policyFor(ctx, bookingType)
+		if err != nil {
+			b.logger.Warnf("Error getting policy: %s", err.Error())
+			return models.Booking{}, err
+		}
+		if err := b.checkZoneGroups(ctx, policy, resource.Zone, booking.UserId); err != nil {
+			b.logger.Warn(err)
+			return models.Booking{}, err
+		}
+	}
+	location, err := b.zoneLocation(ctx, resource.Zone)
+	if err != nil {
+		return models.Booking{}, err
+	}
+	buffer, err := b.bufferFor(ctx, bookingType, resource.Id)
+	if err != nil {
+		return models.Booking{}, err
+	}
+
+	moved, err := b.bookingUpdater.MoveBooking(ctx, bookingType, bookingId, booking.UserId, userId, resource.Id, buffer, location.String())
+	if err != nil {
+		b.logger.Warnf("Error moving booking: %s", err.Error())
+		return models.Booking{}, err
+	}
+	b.reportChange(ctx, bookingType, moved, resource, utills.ClickStatusMoved)
+	b.promoteWaitlist(ctx, bookingType, booking.ResourceId, booking.StartTime, booking.EndTime)
+	return moved, nil
+}
+
+// TransferBooking hands an active booking over to another user, fromUserId is the owner or their
+// delegate. Without acceptance the owner changes right away, otherwise the recipient gets an offer
+// to answer with RespondToTransfer.
+func (b BookingService) TransferBooking(ctx context.Context, bookingType string, bookingId int64, fromUserId, toUserId string, requireAcceptance bool) (models.Booking, models.BookingTransfer, error) {
+	booking, err := b.bookingGetter.GetBookingsById(ctx, bookingType, bookingId)
+	if err != nil {
+		b.logger.Warnf("Error getting booking: %s", err.Error())
+		return models.Booking{}, models.BookingTransfer{}, err
+	}
+	if err := b.checkOwner(ctx, bookingType, fromUserId, booking.UserId); err != nil {
+		b.logger.Warn(err)
+		return models.Booking{}, models.BookingTransfer{}, err
+	}
+	if booking.UserId == toUserId {
+		return models.Booking{}, models.BookingTransfer{}, utills.ErrTransferToOwner
+	}
+	resource, err := b.getResource(ctx, bookingType, booking.ResourceId)
+	if err != nil {
+		b.logger.Warn("Error getting resource ", err)
+		return models.Booking{}, models.BookingTransfer{}, err
+	}
+	if err := b.checkRecipientPolicy(ctx, bookingType, resource.Zone, booking, toUserId); err != nil {
+		b.logger.Warn(err)
+		return models.Booking{}, models.BookingTransfer{}, err
+	}
+
+	if requireAcceptance {
+		transfer, err := b.bookingUpdater.CreateTransferOffer(ctx, models.BookingTransfer{
+			BookingType: bookingType,
+			BookingId:   bookingId,
+			FromUserId:  booking.UserId,
+			ToUserId:    toUserId,
+		}, models.BookingEvent{
+			EventType:   utills.EventTransferOffered,
+			UserId:      toUserId,
+			BookingType: bookingType,
+			BookingId:   bookingId,
+			Payload: map[string]string{
+				"from_user_id": booking.UserId,
+				"start_time":   booking.StartTime.Format(time.RFC3339),
+				"end_time":     booking.EndTime.Format(time.RFC3339),
+			},
+		})
+		if err != nil {
+			b.logger.Warnf("Error offering booking transfer: %s", err.Error())
+			return models.Booking{}, models.BookingTransfer{}, err
+		}
+		return booking, transfer, nil
+	}
+
+	transferred, err := b.bookingUpdater.TransferBooking(ctx, bookingType, bookingId, booking.UserId, toUserId, fromUserId, b.checkQuota)
+	if err != nil {
+		b.logger.Warnf("Error transferring booking: %s", err.Error())
+		return models.Booking{}, models.BookingTransfer{}, err
+	}
+	b.reportChange(ctx, bookingType, transferred, resource, utills.ClickStatusTransferred)
+	return transferred, models.BookingTransfer{}, nil
+}
+
+// RespondToTransfer stores the answer of the recipient, or of their delegate, to a transfer offer
+// and returns the booking.
+func (b BookingService) RespondToTransfer(ctx context.Context, transferId int64, userId string, accept bool) (models.Booking, error) {
+	transfer, err := b.bookingGetter.GetTransfer(ctx, transferId)
+	if err != nil {
+		b.logger.Warnf("Error getting transfer: %s", err.Error())
+		return models.Booking{}, err
+	}
+	if err := b.checkOwner(ctx, transfer.BookingType, userId, transfer.ToUserId); err != nil {
+		if !errors.Is(err, utills.ErrNotBookingOwner) {
+			return models.Booking{}, err
+		}
+		b.logger.Warnf("Transfer %d is not offered to %s", transferId, userId)
+		return models.Booking{}, utills.ErrNoRows
+	}
+	booking, err := b.bookingGetter.GetBookingsById(ctx, transfer.BookingType, transfer.BookingId)
+	if err != nil {
+		b.logger.Warnf("Error getting booking: %s", err.Error())
+		return models.Booking{}, err
+	}
+	var resource models.Resource
+	if accept {
+		resource, err = b.getResource(ctx, transfer.BookingType, booking.ResourceId)
+		if err != nil {
+			b.logger.Warn("Error getting resource ", err)
+			return models.Booking{}, err
+		}
+		if err := b.checkRecipientPolicy(ctx, transfer.BookingType, resource.Zone, booking, transfer.ToUserId); err != nil {
+			b.logger.Warn(err)
+			return models.Booking{}, err
+		}
+	}
+
+	transfer, transferred, err := b.bookingUpdater.RespondToTransfer(ctx, transferId, transfer.ToUserId, userId, accept, checkTransferTransition, b.checkQuota)
+	if err != nil {
+		b.logger.Warnf("Error responding to transfer: %s", err.Error())
+		return models.Booking{}, err
+	}
+	if transfer.Status != utills.TransferAccepted {
+		return booking, nil
+	}
+	b.reportChange(ctx, transfer.BookingType, transferred, resource, utills.ClickStatusTransferred)
+	return transferred, nil
+}
+
+// checkRecipientPolicy checks the limits of the user who gets the booking, for them it is a new one.
+func (b BookingService) checkRecipientPolicy(ctx context.Context, bookingType, zone string, booking models.Booking, userId string) error {
+	policy, err := b.policyFor(ctx, bookingType)
+	if err != nil {
+		b.logger.Warnf("Error getting policy: %s", err.Error())
+		return err
+	}
+	if err := b.checkZoneGroups(ctx, policy, zone, userId); err != nil {
+		return err
+	}
+	if err := b.checkActiveBookings(ctx, b.policies, bookingType, policy, userId); err != nil {
+		return err
+	}
+	if policy.MaxDaysPerWeek > 0 {
+		location, err := b.zoneLocation(ctx, zone)
+		if err != nil {
+			return err
+		}
+		booking.UserId = userId
+		booking.StartTime = booking.StartTime.In(location)
+		return b.checkDaysPerWeek(ctx, b.policies, bookingType, policy, booking)
+	}
+	return nil
+}
+
+// reportChange writes a move or a transfer of the booking to ClickHouse.
+func (b BookingService) reportChange(ctx context.Context, bookingType string, booking models.Booking, resource models.Resource, status string) {
+	now := time.Now()
+	err := b.clickhouseCreater.AddToClickHouse(ctx,
+		booking.BookingId,
+		resource.Id,
+		booking.UserId,
+		bookingType,
+		status,
+		resource.Address,
+		resource.Zone,
+		resource.Floor,
+		resource.Number,
+		now,
+		now,
+		booking.StartTime,
+		booking.EndTime,
+		0)
+	if err != nil {
+		b.logger.Warnf("Error adding to clickhouse: %s", err.Error())
+	}
+}
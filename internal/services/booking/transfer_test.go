@@ -0,0 +1,254 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"github.com/pedroxer/booking-service/internal/models"
+	proto_gen "github.com/pedroxer/booking-service/internal/proto_gen/protos"
+	"github.com/pedroxer/booking-service/internal/storage"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"testing"
+	"time"
+)
+
+// fakeTransfers adds transfer offers to fakeBookings.
+type fakeTransfers struct {
+	fakeBookings
+	transfers map[int64]models.BookingTransfer
+}
+
+func (f fakeTransfers) GetTransfer(ctx context.Context, transferId int64) (models.BookingTransfer, error) {
+	transfer, ok := f.transfers[transferId]
+	if !ok {
+		return models.BookingTransfer{}, utills.ErrNoRows
+	}
+	return transfer, nil
+}
+
+// change is what the service asked storage to do with a booking.
+type change struct {
+	ownerId    string
+	toUserId   string
+	changedBy  string
+	resourceId int64
+}
+
+// fakeChanges records moves and transfers, an offer it answers has offerStatus.
+type fakeChanges struct {
+	BookingUpdater
+	changes     *[]change
+	offerStatus string
+}
+
+func (f fakeChanges) MoveBooking(ctx context.Context, bookingType string, bookingId int64, ownerId, changedBy string, resourceId int64, buffer models.Buffer, timeZone string) (models.Booking, error) {
+	*f.changes = append(*f.changes, change{ownerId: ownerId, changedBy: changedBy, resourceId: resourceId})
+	return models.Booking{BookingId: bookingId, UserId: ownerId, ResourceId: resourceId}, nil
+}
+
+func (f fakeChanges) TransferBooking(ctx context.Context, bookingType string, bookingId int64, fromUserId, toUserId, changedBy string, check storage.QuotaCheck) (models.Booking, error) {
+	*f.changes = append(*f.changes, change{ownerId: fromUserId, toUserId: toUserId, changedBy: changedBy})
+	return models.Booking{BookingId: bookingId, UserId: toUserId}, nil
+}
+
+func (f fakeChanges) CreateTransferOffer(ctx context.Context, transfer models.BookingTransfer, event models.BookingEvent) (models.BookingTransfer, error) {
+	*f.changes = append(*f.changes, change{ownerId: transfer.FromUserId, toUserId: transfer.ToUserId})
+	transfer.Status = utills.TransferPending
+	return transfer, nil
+}
+
+func (f fakeChanges) RespondToTransfer(ctx context.Context, transferId int64, userId, changedBy string, accept bool, check storage.TransitionCheck, quota storage.QuotaCheck) (models.BookingTransfer, models.Booking, error) {
+	status := utills.TransferDeclined
+	if accept {
+		status = utills.TransferAccepted
+	}
+	if err := check(f.offerStatus, status); err != nil {
+		return models.BookingTransfer{}, models.Booking{}, err
+	}
+	*f.changes = append(*f.changes, change{toUserId: userId, changedBy: changedBy})
+	transfer := models.BookingTransfer{Id: transferId, BookingType: utills.WorkplaceType, BookingId: 1, ToUserId: userId, Status: status}
+	if !accept {
+		return transfer, models.Booking{}, nil
+	}
+	return transfer, models.Booking{BookingId: 1, UserId: userId}, nil
+}
+
+// fakeClickhouse keeps the last status reported for each booking when reported is set.
+type fakeClickhouse struct {
+	ClickhouseCreater
+	reported map[int64]string
+}
+
+func (f fakeClickhouse) AddToClickHouse(ctx context.Context, bookingId, resourceId int64, userId, bookingType, bookingStatus, address, zone string, floor, number int64, eventDate, eventTime, startBookingTime, endBookingTime time.Time, durationMinutes int64) error {
+	if f.reported != nil {
+		f.reported[bookingId] = bookingStatus
+	}
+	return nil
+}
+
+type fakeWaitlist struct {
+	WaitlistStorage
+}
+
+func (fakeWaitlist) GetWaitlistCandidates(ctx context.Context, bookingType string, resource models.Resource, startTime, endTime time.Time) ([]models.WaitlistEntry, error) {
+	return nil, nil
+}
+
+// transferService has alice's booking 1 on desk 1 in zone A and bob's booking 2 on desk 3 in
+// the lab, which only engineers may book. carol books for alice and dave for bob.
+func transferService(changes *[]change, groups []string, offerStatus string) BookingService {
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	booking := func(id, resourceId int64, userId string) models.Booking {
+		return models.Booking{BookingId: id, UserId: userId, ResourceId: resourceId, StartTime: start, EndTime: start.Add(2 * time.Hour), Status: utills.StatusPending}
+	}
+	workplace := func(id int64, zone string) *proto_gen.Workplace {
+		return &proto_gen.Workplace{Id: id, Zone: zone, Capacity: 1, IsAvailable: true}
+	}
+	return BookingService{
+		logger:         testLogger(),
+		resourceClient: fakeResources{workplaces: []*proto_gen.Workplace{workplace(1, "A"), workplace(2, "A"), workplace(3, "lab"), workplace(4, "lab")}},
+		bookingGetter: fakeTransfers{
+			fakeBookings: fakeBookings{bookings: map[int64]models.Booking{
+				1: booking(1, 1, "alice"),
+				2: booking(2, 3, "bob"),
+			}},
+			transfers: map[int64]models.BookingTransfer{
+				10: {Id: 10, BookingType: utills.WorkplaceType, BookingId: 1, FromUserId: "alice", ToUserId: "bob", Status: offerStatus},
+			},
+		},
+		bookingUpdater:    fakeChanges{changes: changes, offerStatus: offerStatus},
+		clickhouseCreater: fakeClickhouse{},
+		waitlist:          fakeWaitlist{},
+		policies:          fakePolicies{policy: models.Policy{ZoneGroups: map[string][]string{"lab": {"engineers"}}}, groups: groups},
+		calendars:         fakeCalendars{},
+		buffers:           fakeBuffers{},
+		delegations:       fakeDelegations{principals: map[string][]string{"carol": {"alice"}, "dave": {"bob"}}},
+		openingHours:      newOpeningHours(0, 0),
+		location:          time.UTC,
+	}
+}
+
+func wantChanges(t *testing.T, got, want []change) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("got changes %+v, want %+v", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("change %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestMoveBooking(t *testing.T) {
+	tests := []struct {
+		name       string
+		bookingId  int64
+		userId     string
+		resourceId int64
+		groups     []string
+		wantErr    error
+		wantReason string
+		want       []change
+	}{
+		{name: "owner moves", bookingId: 1, userId: "alice", resourceId: 2, want: []change{{ownerId: "alice", changedBy: "alice", resourceId: 2}}},
+		{name: "delegate moves for the owner", bookingId: 1, userId: "carol", resourceId: 2, want: []change{{ownerId: "alice", changedBy: "carol", resourceId: 2}}},
+		{name: "nobody", bookingId: 1, resourceId: 2, wantErr: utills.ErrNotBookingOwner},
+		{name: "another user", bookingId: 1, userId: "bob", resourceId: 2, wantErr: utills.ErrNotBookingOwner},
+		{name: "delegate of another user", bookingId: 1, userId: "dave", resourceId: 2, wantErr: utills.ErrNotBookingOwner},
+		{name: "into a zone of another group", bookingId: 1, userId: "alice", resourceId: 3, wantReason: reasonZoneNotAllowed},
+		{name: "into a zone of the user group", bookingId: 1, userId: "alice", resourceId: 3, groups: []string{"engineers"}, want: []change{{ownerId: "alice", changedBy: "alice", resourceId: 3}}},
+		{name: "within the zone groups are not checked again", bookingId: 2, userId: "bob", resourceId: 4, want: []change{{ownerId: "bob", changedBy: "bob", resourceId: 4}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := make([]change, 0)
+			b := transferService(&changes, tt.groups, utills.TransferPending)
+			_, err := b.MoveBooking(context.Background(), utills.WorkplaceType, tt.bookingId, tt.userId, tt.resourceId)
+			switch {
+			case tt.wantReason != "":
+				wantViolation(t, err, tt.wantReason)
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("got error %v, want %v", err, tt.wantErr)
+				}
+			case err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			}
+			wantChanges(t, changes, tt.want)
+		})
+	}
+}
+
+func TestTransferBooking(t *testing.T) {
+	tests := []struct {
+		name              string
+		fromUserId        string
+		toUserId          string
+		requireAcceptance bool
+		wantErr           error
+		want              []change
+	}{
+		{name: "owner hands over", fromUserId: "alice", toUserId: "bob", want: []change{{ownerId: "alice", toUserId: "bob", changedBy: "alice"}}},
+		{name: "delegate hands over for the owner", fromUserId: "carol", toUserId: "bob", want: []change{{ownerId: "alice", toUserId: "bob", changedBy: "carol"}}},
+		{name: "offer is made in the owner's name", fromUserId: "carol", toUserId: "bob", requireAcceptance: true, want: []change{{ownerId: "alice", toUserId: "bob"}}},
+		{name: "delegate hands over to the owner", fromUserId: "carol", toUserId: "alice", wantErr: utills.ErrTransferToOwner},
+		{name: "another user", fromUserId: "bob", toUserId: "dave", wantErr: utills.ErrNotBookingOwner},
+		{name: "nobody", toUserId: "bob", wantErr: utills.ErrNotBookingOwner},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := make([]change, 0)
+			b := transferService(&changes, nil, utills.TransferPending)
+			_, _, err := b.TransferBooking(context.Background(), utills.WorkplaceType, 1, tt.fromUserId, tt.toUserId, tt.requireAcceptance)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("got error %v, want %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			wantChanges(t, changes, tt.want)
+		})
+	}
+}
+
+func TestRespondToTransfer(t *testing.T) {
+	tests := []struct {
+		name        string
+		offerStatus string
+		userId      string
+		accept      bool
+		wantErr     error
+		wantOwner   string
+		want        []change
+	}{
+		{name: "recipient accepts", offerStatus: utills.TransferPending, userId: "bob", accept: true, wantOwner: "bob", want: []change{{toUserId: "bob", changedBy: "bob"}}},
+		{name: "recipient declines", offerStatus: utills.TransferPending, userId: "bob", wantOwner: "alice", want: []change{{toUserId: "bob", changedBy: "bob"}}},
+		{name: "delegate accepts for the recipient", offerStatus: utills.TransferPending, userId: "dave", accept: true, wantOwner: "bob", want: []change{{toUserId: "bob", changedBy: "dave"}}},
+		{name: "offer is not for the user", offerStatus: utills.TransferPending, userId: "alice", accept: true, wantErr: utills.ErrNoRows},
+		{name: "nobody", offerStatus: utills.TransferPending, accept: true, wantErr: utills.ErrNoRows},
+		{name: "already accepted", offerStatus: utills.TransferAccepted, userId: "bob", accept: true, wantErr: utills.ErrInvalidTransition},
+		{name: "already declined", offerStatus: utills.TransferDeclined, userId: "bob", accept: true, wantErr: utills.ErrInvalidTransition},
+		{name: "cancelled by a newer offer", offerStatus: utills.TransferCancelled, userId: "bob", wantErr: utills.ErrInvalidTransition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes := make([]change, 0)
+			b := transferService(&changes, nil, tt.offerStatus)
+			booking, err := b.RespondToTransfer(context.Background(), 10, tt.userId, tt.accept)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("got error %v, want %v", err, tt.wantErr)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			} else if booking.UserId != tt.wantOwner {
+				t.Errorf("booking belongs to %s, want %s", booking.UserId, tt.wantOwner)
+			}
+			wantChanges(t, changes, tt.want)
+		})
+	}
+}
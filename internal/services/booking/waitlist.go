@@ -0,0 +1,139 @@
+package booking
+
+import (
+	"context"
+	"errors"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/storage"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"strconv"
+	"time"
+)
+
+type WaitlistStorage interface {
+	CreateWaitlistEntry(ctx context.Context, entry models.WaitlistEntry) (models.WaitlistEntry, error)
+	LeaveWaitlist(ctx context.Context, entryId int64, userId string) error
+	GetWaitlist(ctx context.Context, filters []storage.Field, page int64) ([]models.WaitlistEntry, int64, error)
+	GetWaitlistCandidates(ctx context.Context, bookingType string, resource models.Resource, startTime, endTime time.Time) ([]models.WaitlistEntry, error)
+	PromoteWaitlistEntry(ctx context.Context, bookingType string, entryId int64, booking models.Booking, event models.BookingEvent, check storage.QuotaCheck) (models.Booking, error)
+}
+
+func (b BookingService) JoinWaitlist(ctx context.Context, entry models.WaitlistEntry) (models.WaitlistEntry, error) {
+	if entry.ResourceId != 0 {
+		resource, err := b.getResource(ctx, entry.BookingType, entry.ResourceId)
+		if err != nil {
+			b.logger.Warn("Error getting resource ", err)
+			return models.WaitlistEntry{}, err
+		}
+		if !resource.IsAvailable {
+			return models.WaitlistEntry{}, utills.ErrResourceUnavailable
+		}
+	}
+	created, err := b.waitlist.CreateWaitlistEntry(ctx, entry)
+	if err != nil {
+		b.logger.Warnf("Error joining waitlist: %s", err.Error())
+		return models.WaitlistEntry{}, err
+	}
+	return created, nil
+}
+
+func (b BookingService) LeaveWaitlist(ctx context.Context, entryId int64, userId string) (bool, error) {
+	if err := b.waitlist.LeaveWaitlist(ctx, entryId, userId); err != nil {
+		b.logger.Warnf("Error leaving waitlist: %s", err.Error())
+		return false, err
+	}
+	return true, nil
+}
+
+func (b BookingService) ListWaitlist(ctx context.Context, bookingType, userId string, resourceId int64, page int64) ([]models.WaitlistEntry, int64, error) {
+	filters := []storage.Field{{Name: "status", Value: utills.WaitlistWaiting}}
+	if bookingType != "" {
+		filters = append(filters, storage.Field{Name: "booking_type", Value: bookingType})
+	}
+	if userId != "" {
+		filters = append(filters, storage.Field{Name: "user_id", Value: userId})
+	}
+	if resourceId != 0 {
+		filters = append(filters, storage.Field{Name: "resource_id", Value: resourceId})
+	}
+	entries, count, err := b.waitlist.GetWaitlist(ctx, filters, page)
+	if err != nil {
+		b.logger.Warnf("Error getting waitlist: %s", err.Error())
+		return nil, 0, err
+	}
+	return entries, count, nil
+}
+
+// promoteWaitlist is called whenever a slot of the resource becomes free. Waiters are tried
+// by priority and then in FIFO order; a waiter whose range is still partly taken, falls into
+// a closed period or breaks a policy is skipped, so several waiters can be promoted if the freed
+// window fits all of them.
+func (b BookingService) promoteWaitlist(ctx context.Context, bookingType string, resourceId int64, startTime, endTime time.Time) {
+	resource, err := b.getResource(ctx, bookingType, resourceId)
+	if err != nil {
+		b.logger.Warnf("Error getting resource for waitlist promotion: %s", err.Error())
+		return
+	}
+	if !resource.IsAvailable {
+		return
+	}
+	location, err := b.zoneLocation(ctx, resource.Zone)
+	if err != nil {
+		return
+	}
+	buffer, err := b.bufferFor(ctx, bookingType, resource.Id)
+	if err != nil {
+		return
+	}
+	candidates, err := b.waitlist.GetWaitlistCandidates(ctx, bookingType, resource, startTime, endTime)
+	if err != nil {
+		b.logger.Warnf("Error getting waitlist candidates: %s", err.Error())
+		return
+	}
+	for _, entry := range candidates {
+		// Пока пользователь ждал, на это время могли попасть праздник или блокировка
+		if err := b.checkOpen(ctx, bookingType, resource, entry.StartTime, entry.EndTime); err != nil {
+			if errors.Is(err, utills.ErrResourceClosed) {
+				continue
+			}
+			b.logger.Warnf("Error checking opening hours for waitlist entry %d: %s", entry.Id, err.Error())
+			return
+		}
+		booking := withBuffer(models.Booking{
+			UserId:     entry.UserId,
+			ResourceId: resource.Id,
+			StartTime:  entry.StartTime,
+			EndTime:    entry.EndTime,
+			Status:     utills.StatusPending,
+			TimeZone:   location.String(),
+		}, buffer)
+		// Политика могла измениться, пока пользователь ждал, или он уже набрал бронирований
+		if err := b.checkPolicy(ctx, bookingType, resource.Zone, booking, nil); err != nil {
+			if errors.Is(err, utills.ErrPolicyViolation) {
+				continue
+			}
+			b.logger.Warnf("Error checking policy for waitlist entry %d: %s", entry.Id, err.Error())
+			return
+		}
+		promoted, err := b.waitlist.PromoteWaitlistEntry(ctx, bookingType, entry.Id, booking, models.BookingEvent{
+			EventType:   utills.EventWaitlistPromoted,
+			UserId:      entry.UserId,
+			BookingType: bookingType,
+			Payload: map[string]string{
+				"waitlist_id": strconv.FormatInt(entry.Id, 10),
+				"resource_id": strconv.FormatInt(resource.Id, 10),
+				"zone":        resource.Zone,
+				"start_time":  entry.StartTime.Format(time.RFC3339),
+				"end_time":    entry.EndTime.Format(time.RFC3339),
+			},
+		}, b.checkQuota)
+		if errors.Is(err, utills.ErrBookingConflict) || errors.Is(err, utills.ErrNoRows) || errors.Is(err, utills.ErrPolicyViolation) {
+			continue
+		}
+		if err != nil {
+			b.logger.Warnf("Error promoting waitlist entry %d: %s", entry.Id, err.Error())
+			return
+		}
+		b.logger.Infof("waitlist entry %d promoted to booking %d", entry.Id, promoted.BookingId)
+	}
+}
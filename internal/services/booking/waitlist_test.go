@@ -0,0 +1,66 @@
+package booking
+
+import (
+	"context"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/storage"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"slices"
+	"testing"
+	"time"
+)
+
+// fakeCandidates serves the waiting entries and records the ones promoted with a quota check.
+type fakeCandidates struct {
+	WaitlistStorage
+	entries  []models.WaitlistEntry
+	promoted *[]int64
+}
+
+func (f fakeCandidates) GetWaitlistCandidates(ctx context.Context, bookingType string, resource models.Resource, startTime, endTime time.Time) ([]models.WaitlistEntry, error) {
+	return f.entries, nil
+}
+
+func (f fakeCandidates) PromoteWaitlistEntry(ctx context.Context, bookingType string, entryId int64, booking models.Booking, event models.BookingEvent, check storage.QuotaCheck) (models.Booking, error) {
+	if check == nil {
+		return models.Booking{}, utills.ErrNoRows
+	}
+	*f.promoted = append(*f.promoted, entryId)
+	booking.BookingId = entryId
+	return booking, nil
+}
+
+func TestPromoteWaitlist(t *testing.T) {
+	start := time.Now().Add(24 * time.Hour).Truncate(time.Hour)
+	entry := func(id int64, userId string, start time.Time) models.WaitlistEntry {
+		return models.WaitlistEntry{Id: id, UserId: userId, BookingType: utills.WorkplaceType, ResourceId: 1, StartTime: start, EndTime: start.Add(time.Hour)}
+	}
+
+	tests := []struct {
+		name   string
+		policy models.Policy
+		active int64
+		want   []int64
+	}{
+		{name: "every waiter fits", want: []int64{1, 2}},
+		{name: "waiter beyond the booking horizon is skipped", policy: models.Policy{MaxAdvance: 48 * time.Hour}, want: []int64{1}},
+		{name: "waiters over their quota are skipped", policy: models.Policy{MaxActiveBookings: 1}, active: 1, want: []int64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			promoted := make([]int64, 0)
+			b := transferService(nil, nil, utills.TransferPending)
+			b.policies = fakePolicies{policy: tt.policy, active: tt.active}
+			b.waitlist = fakeCandidates{
+				entries:  []models.WaitlistEntry{entry(1, "bob", start), entry(2, "carol", start.AddDate(0, 0, 2))},
+				promoted: &promoted,
+			}
+
+			b.promoteWaitlist(context.Background(), utills.WorkplaceType, 1, start, start.AddDate(0, 0, 3))
+			if !slices.Equal(promoted, tt.want) {
+				t.Fatalf("promoted %v, want %v", promoted, tt.want)
+			}
+		})
+	}
+}
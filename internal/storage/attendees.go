@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+)
+
+// attendeeCondition matches room bookings organised by $n or where $n is invited.
+func attendeeCondition(param string) string {
+	return ` (user_id = ` + param + ` OR id IN (SELECT booking_id FROM booking_service.room_attendees WHERE user_id = ` + param + `))`
+}
+
+func (s *Storage) addAttendeesTx(ctx context.Context, tx pgx.Tx, bookingId int64, attendees []models.Attendee) ([]models.Attendee, error) {
+	query := `INSERT INTO booking_service.room_attendees (booking_id, user_id, status) VALUES ($1, $2, $3)`
+	created := make([]models.Attendee, 0, len(attendees))
+	for _, attendee := range attendees {
+		if _, err := tx.Exec(ctx, query, bookingId, attendee.UserId, utills.AttendeeInvited); err != nil {
+			s.logger.Warn(err)
+			return nil, err
+		}
+		created = append(created, models.Attendee{UserId: attendee.UserId, Status: utills.AttendeeInvited})
+	}
+	return created, nil
+}
+
+// loadAttendees fills in the attendee lists of room bookings with one query.
+func (s *Storage) loadAttendees(ctx context.Context, bookings []models.Booking) error {
+	if len(bookings) == 0 {
+		return nil
+	}
+	index := make(map[int64]int, len(bookings))
+	bookingIds := make([]int64, 0, len(bookings))
+	for i, booking := range bookings {
+		index[booking.BookingId] = i
+		bookingIds = append(bookingIds, booking.BookingId)
+	}
+	rows, err := s.pgDb.Query(ctx, `SELECT booking_id, user_id, status FROM booking_service.room_attendees WHERE booking_id = ANY($1) ORDER BY user_id`, bookingIds)
+	if err != nil {
+		s.logger.Warn(err)
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var bookingId int64
+		var attendee models.Attendee
+		if err := rows.Scan(&bookingId, &attendee.UserId, &attendee.Status); err != nil {
+			s.logger.Warn(err)
+			return err
+		}
+		i := index[bookingId]
+		bookings[i].Attendees = append(bookings[i].Attendees, attendee)
+	}
+	return rows.Err()
+}
+
+// RespondToInvitation stores the answer of an invited user, it can be changed while the booking is active.
+func (s *Storage) RespondToInvitation(ctx context.Context, bookingId int64, userId, status string) error {
+	query := `UPDATE booking_service.room_attendees a SET status = $3, responded_at = now()
+		FROM booking_service.room_bookings b
+		WHERE a.booking_id = $1 AND a.user_id = $2 AND b.id = a.booking_id AND b.status <> ALL($4) AND b.end_date > now()`
+	tag, err := s.pgDb.Exec(ctx, query, bookingId, userId, status, releasedStatuses)
+	if err != nil {
+		s.logger.Warn(err)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return utills.ErrNoRows
+	}
+	return nil
+}
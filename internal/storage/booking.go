@@ -4,7 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
-	"fmt"
+	"github.com/jackc/pgx/v5"
 	"github.com/pedroxer/booking-service/internal/models"
 	"github.com/pedroxer/booking-service/internal/utills"
 	"strings"
@@ -19,38 +19,36 @@ func (s *Storage) GetBookings(ctx context.Context, filters []Field, bookingType
 		"start_date": {NameWhere: "start_date", NameOrder: "start_date"},
 		"end_date":   {NameWhere: "end_date", NameOrder: "end_date"},
 		"status":     {NameWhere: "status", NameOrder: "status"},
+		"series_id":  {NameWhere: "series_id", NameOrder: "series_id"},
 		"created_at": {NameWhere: "created_at", NameOrder: "created_at"},
 		"updated_at": {NameWhere: "updated_at", NameOrder: "updated_at"},
+		"booked_by":  {NameWhere: "booked_by", NameOrder: "booked_by"},
 	}
 
-	var bookingFileds = []string{
-		"id",
-		"user_id",
-		"start_date",
-		"end_date",
-		"status",
-		"created_at",
-		"updated_at",
-	}
-	from := ` FROM `
-
-	switch bookingType {
-	case utills.WorkplaceType:
-		bookingColumnsFields["workplace_id"] = SearchField{NameWhere: "workplace_id", NameOrder: "workplace_id"}
-		from += "booking_service.booking"
-		bookingFileds = append(bookingFileds, "workplace_id")
-	case utills.ParkingType:
-		bookingColumnsFields["parking_space_id"] = SearchField{NameWhere: "parking_space_id", NameOrder: "parking_space_id"}
-		from += "booking_service.parking_bookings"
-		bookingFileds = append(bookingFileds, "parking_space_id")
-	default:
+	table, resourceColumn, err := bookingTable(bookingType)
+	if err != nil {
 		s.logger.Warnf("unknown booking type: %s", bookingType)
-		return nil, 0, fmt.Errorf("booking type %s not supported", bookingType)
+		return nil, 0, err
 	}
+	bookingColumnsFields[resourceColumn] = SearchField{NameWhere: resourceColumn, NameOrder: resourceColumn}
 
-	selectQuery := "SELECT " + strings.Join(bookingFileds, ",") + from
+	selectQuery := "SELECT " + bookingColumns(resourceColumn) + " FROM " + table
 	countQuery := `SELECT count(*) FROM (` + selectQuery
 
+	// Переговорные ищутся по user_id и среди приглашённых
+	var args []interface{}
+	if bookingType == utills.RoomType {
+		searchFilters := make([]Field, 0, len(filters))
+		for _, filter := range filters {
+			if filter.Name == "user_id" {
+				args = append(args, filter.Value)
+				continue
+			}
+			searchFilters = append(searchFilters, filter)
+		}
+		filters = searchFilters
+	}
+
 	where, err := GenerateSearch(bookingColumnsFields, filters)
 	if err != nil {
 		s.logger.Warn(err)
@@ -58,13 +56,19 @@ func (s *Storage) GetBookings(ctx context.Context, filters []Field, bookingType
 	}
 
 	var conditions strings.Builder
-	if len(filters) > 0 {
+	if len(filters) > 0 || len(args) > 0 {
 		conditions.WriteString(" WHERE")
 		conditions.WriteString(where)
 	}
-	selectQuery += conditions.String() + GenerateLimits(page, utills.PageSize)
+	if len(args) > 0 {
+		if len(filters) > 0 {
+			conditions.WriteString("AND")
+		}
+		conditions.WriteString(attendeeCondition("$1"))
+	}
+	selectQuery += conditions.String() + " ORDER BY start_date" + GenerateLimits(page, utills.PageSize)
 
-	rows, err := s.pgDb.Query(ctx, selectQuery)
+	rows, err := s.pgDb.Query(ctx, selectQuery, args...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, 0, utills.ErrNoRows
@@ -76,16 +80,8 @@ func (s *Storage) GetBookings(ctx context.Context, filters []Field, bookingType
 	var bookings []models.Booking
 	var bookingCount int64
 	for rows.Next() {
-		var booking models.Booking
-		if err := rows.Scan(
-			&booking.BookingId,
-			&booking.UserId,
-			&booking.StartTime,
-			&booking.EndTime,
-			&booking.Status,
-			&booking.CreatedAt,
-			&booking.UpdatedAt,
-			&booking.ResourceId); err != nil {
+		booking, err := scanBooking(rows)
+		if err != nil {
 			s.logger.Warn(err)
 			return nil, 0, err
 		}
@@ -93,199 +89,503 @@ func (s *Storage) GetBookings(ctx context.Context, filters []Field, bookingType
 
 	}
 
+	rows.Close()
+	if bookingType == utills.RoomType {
+		if err := s.loadAttendees(ctx, bookings); err != nil {
+			return nil, 0, err
+		}
+	}
+
 	countQuery += conditions.String() + ") as cnt"
 
-	if err := s.pgDb.QueryRow(ctx, countQuery).Scan(&bookingCount); err != nil {
+	if err := s.pgDb.QueryRow(ctx, countQuery, args...).Scan(&bookingCount); err != nil {
 		s.logger.Warn(err)
 		return nil, 0, err
 	}
-
-	if bookingType == utills.WorkplaceType {
-		bookingFileds = bookingFileds[0 : len(bookingColumnsFields)-1]
-		delete(bookingColumnsFields, "workplace_id")
-	} else if bookingType == utills.ParkingType {
-		bookingFileds = bookingFileds[0 : len(bookingColumnsFields)-1]
-		delete(bookingColumnsFields, "parking_space_id")
-	}
 	return bookings, bookingCount, nil
 }
 
 func (s *Storage) GetBookingsById(ctx context.Context, bookingType string, bookingId int64) (models.Booking, error) {
-	var bookingColumnsFields = map[string]SearchField{
-		"id":         {NameWhere: "id", NameOrder: "id"},
-		"user_id":    {NameWhere: "user_id", NameOrder: "user_id"},
-		"start_date": {NameWhere: "start_date", NameOrder: "start_date"},
-		"end_date":   {NameWhere: "end_date", NameOrder: "end_date"},
-		"status":     {NameWhere: "status", NameOrder: "status"},
-		"created_at": {NameWhere: "created_at", NameOrder: "created_at"},
-		"updated_at": {NameWhere: "updated_at", NameOrder: "updated_at"},
+	table, resourceColumn, err := bookingTable(bookingType)
+	if err != nil {
+		s.logger.Warnf("unknown booking type: %s", bookingType)
+		return models.Booking{}, err
 	}
 
-	var bookingFileds = []string{
-		"id",
-		"user_id",
-		"start_date",
-		"end_date",
-		"status",
-		"created_at",
-		"updated_at",
+	selectQuery := "SELECT " + bookingColumns(resourceColumn) + " FROM " + table + " WHERE id = $1"
+	booking, err := scanBooking(s.pgDb.QueryRow(ctx, selectQuery, bookingId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Booking{}, utills.ErrNoRows
+		}
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	if bookingType == utills.RoomType {
+		bookings := []models.Booking{booking}
+		if err := s.loadAttendees(ctx, bookings); err != nil {
+			return models.Booking{}, err
+		}
+		booking = bookings[0]
 	}
+	return booking, nil
+}
 
-	from := ` FROM `
+func (s *Storage) CreateBooking(ctx context.Context, bookingType string, booking models.Booking, check QuotaCheck) (models.Booking, error) {
+	table, resourceColumn, err := bookingTable(bookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
 
-	switch bookingType {
-	case utills.WorkplaceType:
-		from += "booking_service.booking"
-		bookingFileds = append(bookingFileds, "workplace_id")
-	case utills.ParkingType:
-		from += "booking_service.parking_bookings"
-		bookingFileds = append(bookingFileds, "parking_space_id")
-	default:
-		s.logger.Warnf("unknown booking type: %s", bookingType)
-		return models.Booking{}, fmt.Errorf("booking type %s not supported", bookingType)
+	tx, err := s.pgDb.Begin(ctx)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
 	}
+	defer tx.Rollback(ctx)
 
-	selectQuery := "SELECT " + strings.Join(bookingFileds, ",") + from + " WHERE id = $1"
-	var booking models.Booking
-	if err := s.pgDb.QueryRow(ctx, selectQuery, bookingId).Scan(&booking.BookingId,
-		&booking.UserId,
-		&booking.StartTime,
-		&booking.EndTime,
-		&booking.Status,
-		&booking.CreatedAt,
-		&booking.UpdatedAt,
-		&booking.ResourceId); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return models.Booking{}, utills.ErrNoRows
-		}
+	if err := s.checkQuota(ctx, tx, bookingType, booking, check); err != nil {
+		return models.Booking{}, err
+	}
+	created, err := s.createBookingTx(ctx, tx, table, resourceColumn, booking)
+	if err != nil {
+		return models.Booking{}, err
+	}
+	if err := tx.Commit(ctx); err != nil {
 		s.logger.Warn(err)
 		return models.Booking{}, err
 	}
+	return created, nil
+}
 
-	bookingFileds = bookingFileds[0 : len(bookingColumnsFields)-1]
+// createBookingTx inserts the booking. The resource is blocked for [BlockedStart, BlockedEnd),
+// zero values mean the booking has no buffers.
+func (s *Storage) createBookingTx(ctx context.Context, tx pgx.Tx, table, resourceColumn string, booking models.Booking) (models.Booking, error) {
+	if booking.BlockedStart.IsZero() {
+		booking.BlockedStart = booking.StartTime
+	}
+	if booking.BlockedEnd.IsZero() {
+		booking.BlockedEnd = booking.EndTime
+	}
+	if err := s.checkConflict(ctx, tx, table, resourceColumn, booking.ResourceId, 0, booking.BlockedStart, booking.BlockedEnd); err != nil {
+		return models.Booking{}, err
+	}
 
-	return booking, nil
+	query := `INSERT INTO ` + table + ` (user_id, ` + resourceColumn + `, start_date, end_date, status, series_id, created_at, updated_at, licence_plate, bundle_id, time_zone, blocked_start, blocked_end, booked_by)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, 0), $7, $8, NULLIF($9, ''), NULLIF($10, 0), NULLIF($11, ''), $12, $13, NULLIF($14, '')) RETURNING ` + bookingColumns(resourceColumn)
+
+	created, err := scanBooking(tx.QueryRow(ctx, query,
+		booking.UserId,
+		booking.ResourceId,
+		booking.StartTime,
+		booking.EndTime,
+		booking.Status,
+		booking.SeriesId,
+		time.Now(),
+		time.Now(),
+		booking.LicencePlate,
+		booking.BundleId,
+		booking.TimeZone,
+		booking.BlockedStart,
+		booking.BlockedEnd,
+		booking.BookedBy))
+	if err != nil {
+		if isExclusionViolation(err) {
+			return models.Booking{}, s.exclusionConflict(ctx, table, resourceColumn, booking.ResourceId, 0, booking.BlockedStart, booking.BlockedEnd)
+		}
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	if len(booking.Attendees) > 0 {
+		created.Attendees, err = s.addAttendeesTx(ctx, tx, created.BookingId, booking.Attendees)
+		if err != nil {
+			return models.Booking{}, err
+		}
+	}
+	return created, nil
 }
 
-func (s *Storage) CreateBooking(ctx context.Context, bookingType, status string, startTime, endTime time.Time, userId string, resourceId int64) (models.Booking, error) {
-	query := `INSERT INTO `
-	if bookingType == utills.WorkplaceType {
-		query += "booking_service.booking (user_id, workplace_id, start_date, end_date, status, created_at, updated_at) "
-	} else if bookingType == utills.ParkingType {
-		query += "booking_service.parking_bookings (user_id, parking_space_id, start_date, end_date, status, created_at, updated_at) "
+// CreateBookingSeries stores the series together with its occurrences in one transaction. An
+// occurrence that collides with another booking or fails check is reported in the result and
+// skipped, any other error leaves nothing behind, the series included.
+func (s *Storage) CreateBookingSeries(ctx context.Context, bookingType, userId string, resourceId int64, rrule string, occurrences []models.Booking, check QuotaCheck) (models.BookingSeries, error) {
+	table, resourceColumn, err := bookingTable(bookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.BookingSeries{}, err
 	}
-	query += " VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING *"
 
-	var booking models.Booking
-	if err := s.pgDb.QueryRow(ctx, query, userId, resourceId, startTime, endTime, status, time.Now(), time.Now()).Scan(&booking.BookingId,
-		&booking.UserId,
-		&booking.ResourceId,
-		&booking.StartTime,
-		&booking.EndTime,
-		&booking.Status,
-		&booking.CreatedAt,
-		&booking.UpdatedAt); err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return models.Booking{}, utills.ErrNoRows
+	tx, err := s.pgDb.Begin(ctx)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.BookingSeries{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `INSERT INTO booking_service.booking_series (user_id, booking_type, resource_id, rrule) VALUES ($1, $2, $3, $4) RETURNING id`
+	series := models.BookingSeries{
+		Bookings:  make([]models.Booking, 0, len(occurrences)),
+		Conflicts: make([]models.OccurrenceConflict, 0),
+	}
+	if err := tx.QueryRow(ctx, query, userId, bookingType, resourceId, rrule).Scan(&series.SeriesId); err != nil {
+		s.logger.Warn(err)
+		return models.BookingSeries{}, err
+	}
+	for _, occurrence := range occurrences {
+		occurrence.SeriesId = series.SeriesId
+		booking, err := s.createOccurrenceTx(ctx, tx, bookingType, table, resourceColumn, occurrence, check)
+		if errors.Is(err, utills.ErrBookingConflict) || errors.Is(err, utills.ErrPolicyViolation) {
+			conflict := models.OccurrenceConflict{
+				StartTime: occurrence.StartTime,
+				EndTime:   occurrence.EndTime,
+				Reason:    err.Error(),
+			}
+			var conflictErr *utills.BookingConflictError
+			if errors.As(err, &conflictErr) {
+				conflict.ConflictingBookingId = conflictErr.BookingId
+			}
+			series.Conflicts = append(series.Conflicts, conflict)
+			continue
+		}
+		if err != nil {
+			return models.BookingSeries{}, err
 		}
+		series.Bookings = append(series.Bookings, booking)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		s.logger.Warn(err)
+		return models.BookingSeries{}, err
+	}
+	return series, nil
+}
+
+// createOccurrenceTx inserts one occurrence under a savepoint, so a violated constraint only
+// rolls back this occurrence and not the whole series.
+func (s *Storage) createOccurrenceTx(ctx context.Context, tx pgx.Tx, bookingType, table, resourceColumn string, occurrence models.Booking, check QuotaCheck) (models.Booking, error) {
+	savepoint, err := tx.Begin(ctx)
+	if err != nil {
 		s.logger.Warn(err)
 		return models.Booking{}, err
 	}
+	defer savepoint.Rollback(ctx)
 
+	if err := s.checkQuota(ctx, savepoint, bookingType, occurrence, check); err != nil {
+		return models.Booking{}, err
+	}
+	booking, err := s.createBookingTx(ctx, savepoint, table, resourceColumn, occurrence)
+	if err != nil {
+		return models.Booking{}, err
+	}
+	if err := savepoint.Commit(ctx); err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
 	return booking, nil
 }
 
-func (s *Storage) ApproveBooking(ctx context.Context, workplaceId int64) (bool, int64, error) {
-	query := `UPDATE booking_service.booking SET status = $2 WHERE workplace_id = $1 RETURNING id`
+// ApproveBooking confirms the booking of the resource that is current: it has not ended yet
+// and starts no later than before from now. A pending booking can only be confirmed until
+// after past its start, later it is left to the no-show releaser. The booking must belong
+// to userId or, when licencePlate is set, be made for that car.
+func (s *Storage) ApproveBooking(ctx context.Context, bookingType string, resourceId int64, userId, licencePlate string, before, after time.Duration, check TransitionCheck) (models.Booking, error) {
+	table, resourceColumn, err := bookingTable(bookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
 
-	var bookingId int64
-	if err := s.pgDb.QueryRow(ctx, query, workplaceId, utills.StatusConfirmed).Scan(&bookingId); err != nil {
+	tx, err := s.pgDb.Begin(ctx)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	// Ищется бронь именно этого пользователя (или машины), чужая бронь в том же окне не мешает
+	owner := `user_id = $6`
+	ownerValue := userId
+	if licencePlate != "" {
+		owner = `licence_plate = $6`
+		ownerValue = licencePlate
+	}
+	query := `SELECT ` + bookingColumns(resourceColumn) + ` FROM ` + table + `
+		WHERE ` + resourceColumn + ` = $1 AND start_date <= $2 AND end_date > $3 AND status <> ALL($4) AND ` + owner + `
+		ORDER BY status = $5 DESC, start_date LIMIT 1 FOR UPDATE`
+	now := time.Now()
+	current, err := scanBooking(tx.QueryRow(ctx, query, resourceId, now.Add(before), now, releasedStatuses, utills.StatusPending, ownerValue))
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return false, -1, utills.ErrNoRows
+			return models.Booking{}, s.noOwnBookingError(ctx, tx, table, resourceColumn, resourceId, now.Add(before), now)
 		}
 		s.logger.Warn(err)
-		return false, -1, err
+		return models.Booking{}, err
+	}
+	if current.Status == utills.StatusPending && current.StartTime.Add(after).Before(now) {
+		return models.Booking{}, utills.ErrOutsideCheckInWindow
+	}
+	if err := check(current.Status, utills.StatusConfirmed); err != nil {
+		return models.Booking{}, err
+	}
+
+	updateQuery := `UPDATE ` + table + ` SET status = $2, updated_at = now() WHERE id = $1 RETURNING ` + bookingColumns(resourceColumn)
+	booking, err := scanBooking(tx.QueryRow(ctx, updateQuery, current.BookingId, utills.StatusConfirmed))
+	if err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	if err := s.addStatusHistory(ctx, tx, bookingType, booking.BookingId, current.Status, booking.Status); err != nil {
+		return models.Booking{}, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	return booking, nil
+}
+
+// noOwnBookingError tells why there is no booking to check in: the resource is booked by
+// someone else at the moment or it is not booked at all.
+func (s *Storage) noOwnBookingError(ctx context.Context, tx pgx.Tx, table, resourceColumn string, resourceId int64, startBefore, endAfter time.Time) error {
+	query := `SELECT EXISTS (SELECT 1 FROM ` + table + `
+		WHERE ` + resourceColumn + ` = $1 AND start_date <= $2 AND end_date > $3 AND status <> ALL($4))`
+	var booked bool
+	if err := tx.QueryRow(ctx, query, resourceId, startBefore, endAfter, releasedStatuses).Scan(&booked); err != nil {
+		s.logger.Warn(err)
+		return err
+	}
+	if booked {
+		return utills.ErrNotBookingOwner
 	}
-	return true, bookingId, nil
+	return utills.ErrNoRows
 }
 
-func (s *Storage) UpdateBooking(ctx context.Context, bookingID int64, updateFields []Field, bookingType string) (models.Booking, error) {
-	var bookingColumnsFields = map[string]SearchField{
-		"id":         {NameWhere: "id", NameOrder: "id"},
-		"user_id":    {NameWhere: "user_id", NameOrder: "user_id"},
-		"start_date": {NameWhere: "start_date", NameOrder: "start_date"},
-		"end_date":   {NameWhere: "end_date", NameOrder: "end_date"},
-		"status":     {NameWhere: "status", NameOrder: "status"},
-		"created_at": {NameWhere: "created_at", NameOrder: "created_at"},
-		"updated_at": {NameWhere: "updated_at", NameOrder: "updated_at"},
+// noShowQuery releases pending bookings that are not guest bookings, the parameters are the new
+// and the pending status, since, the deadline and the booking type.
+func noShowQuery(table, resourceColumn string) string {
+	return `UPDATE ` + table + ` SET status = $1, updated_at = now() WHERE status = $2 AND start_date >= $3 AND start_date < $4 AND` +
+		guestCondition("$5") + ` RETURNING ` + bookingColumns(resourceColumn)
+}
+
+// MarkNoShowBookings moves pending bookings that started in [since, deadline) to NO_SHOW,
+// which releases the rest of their slot. Guests can check in until the end of the visit,
+// their bookings expire instead, see ExpireGuestBookings.
+func (s *Storage) MarkNoShowBookings(ctx context.Context, bookingType string, since, deadline time.Time) ([]models.Booking, error) {
+	table, resourceColumn, err := bookingTable(bookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
 	}
 
-	var bookingFileds = []string{
-		"id",
-		"user_id",
-		"start_date",
-		"end_date",
-		"status",
-		"created_at",
-		"updated_at",
-	}
-
-	updateQuery := ` UPDATE`
-	switch bookingType {
-	case utills.WorkplaceType:
-		bookingColumnsFields["workplace_id"] = SearchField{NameWhere: "workplace_id", NameOrder: "workplace_id"}
-		updateQuery += "booking"
-		bookingFileds = append(bookingFileds, "workplace_id")
-	case utills.ParkingType:
-		bookingColumnsFields["parking_space_id"] = SearchField{NameWhere: "parking_space_id", NameOrder: "parking_space_id"}
-		updateQuery += "parking_bookings"
-		bookingFileds = append(bookingFileds, "parking_space_id")
-	default:
+	tx, err := s.pgDb.Begin(ctx)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	bookings, err := s.queryBookings(ctx, tx, noShowQuery(table, resourceColumn), utills.StatusNoShow, utills.StatusPending, since, deadline, bookingType)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.addStatusHistoryBatch(ctx, tx, bookingType, bookings, utills.StatusPending, utills.StatusNoShow); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	return bookings, nil
+}
+
+func (s *Storage) UpdateBooking(ctx context.Context, bookingID int64, updateFields []Field, bookingType string, check TransitionCheck) (models.Booking, error) {
+	table, resourceColumn, err := bookingTable(bookingType)
+	if err != nil {
 		s.logger.Warnf("unknown booking type: %s", bookingType)
-		return models.Booking{}, fmt.Errorf("booking type %s not supported", bookingType)
+		return models.Booking{}, err
 	}
-	updateQuery += " SET "
 
-	updates, err := GenerateUpdates(bookingColumnsFields, updateFields)
+	tx, err := s.pgDb.Begin(ctx)
 	if err != nil {
 		s.logger.Warn(err)
 		return models.Booking{}, err
 	}
-	updateQuery += updates + fmt.Sprintf(" WHERE id = %d RETURNING *", bookingID)
-	var booking models.Booking
-	if err := s.pgDb.QueryRow(ctx, updateQuery, bookingID).Scan(&booking.BookingId,
-		&booking.UserId,
-		&booking.StartTime,
-		&booking.EndTime,
-		&booking.Status,
-		&booking.CreatedAt,
-		&booking.UpdatedAt,
-		&booking.ResourceId); err != nil {
+	defer tx.Rollback(ctx)
+
+	booking, err := s.updateBookingTx(ctx, tx, bookingType, table, resourceColumn, bookingID, updateFields, 0, 0, check, nil)
+	if err != nil {
+		return models.Booking{}, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	return booking, nil
+}
+
+// ShiftCheck validates a booking of a series at the times it is moved to, current is the booking
+// before the move.
+type ShiftCheck func(current, shifted models.Booking) error
+
+// UpdateSeriesBookings applies updateFields to every booking of the series starting at or after from
+// and moves their start and end by the given shifts. Every moved booking is validated with shift.
+// Either all bookings are updated or none.
+func (s *Storage) UpdateSeriesBookings(ctx context.Context, bookingType string, seriesId int64, from time.Time, startShift, endShift time.Duration, updateFields []Field, check TransitionCheck, shift ShiftCheck) ([]models.Booking, error) {
+	table, resourceColumn, err := bookingTable(bookingType)
+	if err != nil {
+		s.logger.Warnf("unknown booking type: %s", bookingType)
+		return nil, err
+	}
+
+	tx, err := s.pgDb.Begin(ctx)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	bookingIds, err := s.seriesBookingIds(ctx, tx, table, seriesId, from)
+	if err != nil {
+		return nil, err
+	}
+	bookings := make([]models.Booking, 0, len(bookingIds))
+	for _, bookingId := range bookingIds {
+		booking, err := s.updateBookingTx(ctx, tx, bookingType, table, resourceColumn, bookingId, updateFields, startShift, endShift, check, shift)
+		if err != nil {
+			return nil, err
+		}
+		bookings = append(bookings, booking)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	return bookings, nil
+}
+
+func (s *Storage) seriesBookingIds(ctx context.Context, tx pgx.Tx, table string, seriesId int64, from time.Time) ([]int64, error) {
+	rows, err := tx.Query(ctx, `SELECT id FROM `+table+` WHERE series_id = $1 AND start_date >= $2 ORDER BY start_date FOR UPDATE`, seriesId, from)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	defer rows.Close()
+	var bookingIds []int64
+	for rows.Next() {
+		var bookingId int64
+		if err := rows.Scan(&bookingId); err != nil {
+			s.logger.Warn(err)
+			return nil, err
+		}
+		bookingIds = append(bookingIds, bookingId)
+	}
+	return bookingIds, rows.Err()
+}
+
+func (s *Storage) updateBookingTx(ctx context.Context, tx pgx.Tx, bookingType, table, resourceColumn string, bookingID int64, updateFields []Field, startShift, endShift time.Duration, check TransitionCheck, shift ShiftCheck) (models.Booking, error) {
+	var bookingColumnsFields = map[string]SearchField{
+		"user_id":       {NameWhere: "user_id", NameOrder: "user_id"},
+		"start_date":    {NameWhere: "start_date", NameOrder: "start_date"},
+		"end_date":      {NameWhere: "end_date", NameOrder: "end_date"},
+		"status":        {NameWhere: "status", NameOrder: "status"},
+		"blocked_start": {NameWhere: "blocked_start", NameOrder: "blocked_start"},
+		"blocked_end":   {NameWhere: "blocked_end", NameOrder: "blocked_end"},
+	}
+	bookingColumnsFields[resourceColumn] = SearchField{NameWhere: resourceColumn, NameOrder: resourceColumn}
+
+	current, err := scanBooking(tx.QueryRow(ctx, `SELECT `+bookingColumns(resourceColumn)+` FROM `+table+` WHERE id = $1 FOR UPDATE`, bookingID))
+	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return models.Booking{}, utills.ErrNoRows
 		}
 		s.logger.Warn(err)
 		return models.Booking{}, err
 	}
+
+	fields := make([]Field, 0, len(updateFields)+2)
+	if startShift != 0 {
+		fields = append(fields, Field{Name: "start_date", Value: current.StartTime.Add(startShift)})
+	}
+	if endShift != 0 {
+		fields = append(fields, Field{Name: "end_date", Value: current.EndTime.Add(endShift)})
+	}
+	fields = append(fields, updateFields...)
+
+	startTime, endTime, resourceId, status := current.StartTime, current.EndTime, current.ResourceId, current.Status
+	for _, field := range fields {
+		switch field.Name {
+		case "start_date":
+			startTime = field.Value.(time.Time)
+		case "end_date":
+			endTime = field.Value.(time.Time)
+		case resourceColumn:
+			resourceId = field.Value.(int64)
+		case "status":
+			status = field.Value.(string)
+		}
+	}
+	if status != current.Status {
+		if err := check(current.Status, status); err != nil {
+			return models.Booking{}, err
+		}
+		if err := s.addStatusHistory(ctx, tx, bookingType, bookingID, current.Status, status); err != nil {
+			return models.Booking{}, err
+		}
+	}
+	blockedStart, blockedEnd := current.BlockedStart, current.BlockedEnd
+	if !startTime.Equal(current.StartTime) || !endTime.Equal(current.EndTime) || resourceId != current.ResourceId {
+		// Буферы брони сохраняются, сдвигаются вместе с её временем
+		blockedStart = startTime.Add(-current.StartTime.Sub(current.BlockedStart))
+		blockedEnd = endTime.Add(current.BlockedEnd.Sub(current.EndTime))
+		if shift != nil {
+			shifted := current
+			shifted.StartTime, shifted.EndTime, shifted.ResourceId, shifted.Status = startTime, endTime, resourceId, status
+			if err := shift(current, shifted); err != nil {
+				return models.Booking{}, err
+			}
+		}
+		if err := s.checkConflict(ctx, tx, table, resourceColumn, resourceId, bookingID, blockedStart, blockedEnd); err != nil {
+			return models.Booking{}, err
+		}
+		fields = append(fields, Field{Name: "blocked_start", Value: blockedStart}, Field{Name: "blocked_end", Value: blockedEnd})
+	}
+
+	updates, args, err := GenerateUpdates(bookingColumnsFields, fields, 1)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	if updates != "" {
+		updates += ", "
+	}
+	updateQuery := `UPDATE ` + table + ` SET ` + updates + `updated_at = now() WHERE id = $1 RETURNING ` + bookingColumns(resourceColumn)
+	booking, err := scanBooking(tx.QueryRow(ctx, updateQuery, append([]interface{}{bookingID}, args...)...))
+	if err != nil {
+		if isExclusionViolation(err) {
+			return models.Booking{}, s.exclusionConflict(ctx, table, resourceColumn, resourceId, bookingID, blockedStart, blockedEnd)
+		}
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
 	return booking, nil
 }
 
-func (s *Storage) GetTimeSlotsForResource(ctx context.Context, bookingType string, resourceId int64, date time.Time) ([]models.TimeSlot, error) {
-	query := `SELECT start_date, end_date FROM `
-	if bookingType == utills.WorkplaceType {
-		query += "booking_service.booking WHERE workplace_id = $1 "
-	} else if bookingType == utills.ParkingType {
-		query += "booking_service.parking_bookings WHERE parking_space_id = $1 "
-	}
-	query += "AND start_date >= $2 AND end_date <= $3 ORDER BY start_date"
-	rows, err := s.pgDb.Query(ctx, query, resourceId, date.Format(utills.TimeLayout), date.Add(time.Hour*24).Format(utills.TimeLayout))
-	if errors.Is(err, sql.ErrNoRows) {
-		return nil, utills.ErrNoRows
+// GetTimeSlotsForResource returns the blocked ranges, bookings with their buffers, of the resource
+// that overlap [from, to), including the ones that only start or end inside it.
+func (s *Storage) GetTimeSlotsForResource(ctx context.Context, bookingType string, resourceId int64, from, to time.Time) ([]models.TimeSlot, error) {
+	table, resourceColumn, err := bookingTable(bookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
 	}
+	query := blockingRows(table, `blocked_start, blocked_end`,
+		resourceColumn+` = $1 AND blocked_start < $3 AND blocked_end > $2 AND status <> ALL($4)`, ``) + ` ORDER BY blocked_start`
+	rows, err := s.pgDb.Query(ctx, query, resourceId, from, to, releasedStatuses)
 	if err != nil {
 		s.logger.Warn(err)
 		return nil, err
 	}
+	defer rows.Close()
 	var timeSlots []models.TimeSlot
 	for rows.Next() {
 		var timeSlot models.TimeSlot
@@ -297,23 +597,292 @@ func (s *Storage) GetTimeSlotsForResource(ctx context.Context, bookingType strin
 		timeSlots = append(timeSlots, timeSlot)
 	}
 
-	return timeSlots, nil
+	return timeSlots, rows.Err()
 
 }
-func (s *Storage) DeleteBooking(ctx context.Context, bookingType string, bookingId int64) error {
-	query := `DELETE FROM `
-	if bookingType == utills.WorkplaceType {
-		query += "booking_service.booking WHERE id = $1"
-	} else {
-		query += "booking_service.parking_bookings WHERE id = $1"
-	}
-	if _, err := s.pgDb.Exec(ctx, query, bookingId); err != nil {
+
+// CancelBooking keeps the cancelled booking with the time, author and reason of the cancellation.
+func (s *Storage) CancelBooking(ctx context.Context, bookingType string, bookingId int64, cancelledBy, reason string, check TransitionCheck) (models.Booking, error) {
+	table, resourceColumn, err := bookingTable(bookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+
+	tx, err := s.pgDb.Begin(ctx)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	var status string
+	if err := tx.QueryRow(ctx, `SELECT status FROM `+table+` WHERE id = $1 FOR UPDATE`, bookingId).Scan(&status); err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
-			return utills.ErrNoRows
+			return models.Booking{}, utills.ErrNoRows
 		}
 		s.logger.Warn(err)
-		return err
+		return models.Booking{}, err
+	}
+	if err := check(status, utills.StatusCancelled); err != nil {
+		return models.Booking{}, err
+	}
+	query := `UPDATE ` + table + ` SET status = $2, cancelled_at = now(), cancelled_by = NULLIF($3, ''), cancel_reason = NULLIF($4, ''), updated_at = now()
+		WHERE id = $1 RETURNING ` + bookingColumns(resourceColumn)
+	booking, err := scanBooking(tx.QueryRow(ctx, query, bookingId, utills.StatusCancelled, cancelledBy, reason))
+	if err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	if err := s.addStatusHistory(ctx, tx, bookingType, bookingId, status, utills.StatusCancelled); err != nil {
+		return models.Booking{}, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
 	}
-	return nil
+	return booking, nil
+}
 
+func bookingColumns(resourceColumn string) string {
+	return "id, user_id, " + resourceColumn + ", start_date, end_date, status, COALESCE(series_id, 0), created_at, updated_at, COALESCE(licence_plate, ''), " +
+		"cancelled_at, COALESCE(cancelled_by, ''), COALESCE(cancel_reason, ''), COALESCE(bundle_id, 0), COALESCE(time_zone, ''), blocked_start, blocked_end, COALESCE(booked_by, '')"
+}
+
+func scanBooking(row pgx.Row) (models.Booking, error) {
+	var booking models.Booking
+	err := row.Scan(&booking.BookingId,
+		&booking.UserId,
+		&booking.ResourceId,
+		&booking.StartTime,
+		&booking.EndTime,
+		&booking.Status,
+		&booking.SeriesId,
+		&booking.CreatedAt,
+		&booking.UpdatedAt,
+		&booking.LicencePlate,
+		&booking.CancelledAt,
+		&booking.CancelledBy,
+		&booking.CancelReason,
+		&booking.BundleId,
+		&booking.TimeZone,
+		&booking.BlockedStart,
+		&booking.BlockedEnd,
+		&booking.BookedBy)
+	return booking, err
+}
+
+func (s *Storage) GetBookedResourceIds(ctx context.Context, bookingType string) ([]int64, error) {
+	table, resourceColumn, err := bookingTable(bookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	rows, err := s.pgDb.Query(ctx, `SELECT DISTINCT `+resourceColumn+` FROM `+table+` WHERE status <> ALL($1)`, releasedStatuses)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	defer rows.Close()
+	var resourceIds []int64
+	for rows.Next() {
+		var resourceId int64
+		if err := rows.Scan(&resourceId); err != nil {
+			s.logger.Warn(err)
+			return nil, err
+		}
+		resourceIds = append(resourceIds, resourceId)
+	}
+	return resourceIds, rows.Err()
+}
+
+// CancelSeriesBookings cancels the bookings of the series starting at or after from whose
+// status is one of statuses, bookings in other statuses are left as they are.
+func (s *Storage) CancelSeriesBookings(ctx context.Context, bookingType string, seriesId int64, from time.Time, statuses []string, cancelledBy, reason string) ([]models.Booking, error) {
+	tx, err := s.pgDb.Begin(ctx)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	bookings, err := s.cancelBookingsTx(ctx, tx, bookingType, `series_id = $1 AND start_date >= $2 AND status = ANY($3)`, cancelledBy, reason, seriesId, from, statuses)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	return bookings, nil
+}
+
+// cancelBookingsTx cancels the bookings matching condition, args are its parameters.
+func (s *Storage) cancelBookingsTx(ctx context.Context, tx pgx.Tx, bookingType, condition, cancelledBy, reason string, args ...interface{}) ([]models.Booking, error) {
+	table, resourceColumn, err := bookingTable(bookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	// Прежний статус нужен для истории, поэтому строки блокируются и читаются до обновления
+	previous, err := s.queryBookings(ctx, tx, `SELECT `+bookingColumns(resourceColumn)+` FROM `+table+` WHERE `+condition+` FOR UPDATE`, args...)
+	if err != nil {
+		return nil, err
+	}
+	query := `UPDATE ` + table + ` SET status = $2, cancelled_at = now(), cancelled_by = NULLIF($3, ''), cancel_reason = NULLIF($4, ''), updated_at = now()
+		WHERE id = $1 RETURNING ` + bookingColumns(resourceColumn)
+	bookings := make([]models.Booking, 0, len(previous))
+	for _, current := range previous {
+		booking, err := scanBooking(tx.QueryRow(ctx, query, current.BookingId, utills.StatusCancelled, cancelledBy, reason))
+		if err != nil {
+			s.logger.Warn(err)
+			return nil, err
+		}
+		if err := s.addStatusHistory(ctx, tx, bookingType, booking.BookingId, current.Status, booking.Status); err != nil {
+			return nil, err
+		}
+		bookings = append(bookings, booking)
+	}
+	return bookings, nil
+}
+
+func (s *Storage) queryBookings(ctx context.Context, tx pgx.Tx, query string, args ...interface{}) ([]models.Booking, error) {
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	defer rows.Close()
+	var bookings []models.Booking
+	for rows.Next() {
+		booking, err := scanBooking(rows)
+		if err != nil {
+			s.logger.Warn(err)
+			return nil, err
+		}
+		bookings = append(bookings, booking)
+	}
+	if err := rows.Err(); err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	return bookings, nil
+}
+
+// GetCurrentBooking returns the booking that occupies the resource at the given moment.
+func (s *Storage) GetCurrentBooking(ctx context.Context, bookingType string, resourceId int64, at time.Time) (models.Booking, error) {
+	table, resourceColumn, err := bookingTable(bookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	query := `SELECT ` + bookingColumns(resourceColumn) + ` FROM ` + table + `
+		WHERE ` + resourceColumn + ` = $1 AND start_date <= $2 AND end_date > $2 AND status <> ALL($3)
+		ORDER BY start_date LIMIT 1`
+	booking, err := scanBooking(s.pgDb.QueryRow(ctx, query, resourceId, at, releasedStatuses))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Booking{}, utills.ErrNoRows
+		}
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	return booking, nil
+}
+
+// CheckOutBooking finishes the booking of userId now: its end is cut to the current time,
+// so the rest of the slot can be booked again, and its status becomes DONE.
+func (s *Storage) CheckOutBooking(ctx context.Context, bookingType string, bookingId int64, userId string, check TransitionCheck) (models.CheckOut, error) {
+	table, resourceColumn, err := bookingTable(bookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.CheckOut{}, err
+	}
+
+	tx, err := s.pgDb.Begin(ctx)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.CheckOut{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	current, err := scanBooking(tx.QueryRow(ctx, `SELECT `+bookingColumns(resourceColumn)+` FROM `+table+` WHERE id = $1 FOR UPDATE`, bookingId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.CheckOut{}, utills.ErrNoRows
+		}
+		s.logger.Warn(err)
+		return models.CheckOut{}, err
+	}
+	if current.UserId != userId {
+		return models.CheckOut{}, utills.ErrNotBookingOwner
+	}
+	if err := check(current.Status, utills.StatusDone); err != nil {
+		return models.CheckOut{}, err
+	}
+
+	// Бронь, из которой ушли до начала, всё равно должна оставаться непустым интервалом
+	endTime := time.Now()
+	if !endTime.After(current.StartTime) {
+		endTime = current.StartTime.Add(time.Minute)
+	}
+	if endTime.After(current.EndTime) {
+		endTime = current.EndTime
+	}
+	// Буфер после брони (уборка) начинается с фактического ухода
+	blockedEnd := endTime.Add(current.BlockedEnd.Sub(current.EndTime))
+	updateQuery := `UPDATE ` + table + ` SET status = $2, end_date = $3, blocked_end = $4, updated_at = now() WHERE id = $1 RETURNING ` + bookingColumns(resourceColumn)
+	booking, err := scanBooking(tx.QueryRow(ctx, updateQuery, bookingId, utills.StatusDone, endTime, blockedEnd))
+	if err != nil {
+		s.logger.Warn(err)
+		return models.CheckOut{}, err
+	}
+	if err := s.addStatusHistory(ctx, tx, bookingType, bookingId, current.Status, booking.Status); err != nil {
+		return models.CheckOut{}, err
+	}
+
+	checkOut := models.CheckOut{Booking: booking, PlannedEnd: current.EndTime, CheckedInAt: booking.StartTime}
+	var checkedInAt *time.Time
+	historyQuery := `SELECT max(changed_at) FROM booking_service.booking_status_history WHERE booking_id = $1 AND booking_type = $2 AND to_status = $3`
+	if err := tx.QueryRow(ctx, historyQuery, bookingId, bookingType, utills.StatusConfirmed).Scan(&checkedInAt); err != nil {
+		s.logger.Warn(err)
+		return models.CheckOut{}, err
+	}
+	if checkedInAt != nil && checkedInAt.After(checkOut.CheckedInAt) && checkedInAt.Before(booking.EndTime) {
+		checkOut.CheckedInAt = *checkedInAt
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		s.logger.Warn(err)
+		return models.CheckOut{}, err
+	}
+	return checkOut, nil
+}
+
+// GetResourcesOccupancy returns the blocked range and resource of every booking of the given
+// resources that overlaps [from, to).
+func (s *Storage) GetResourcesOccupancy(ctx context.Context, bookingType string, resourceIds []int64, from, to time.Time) ([]models.Booking, error) {
+	table, resourceColumn, err := bookingTable(bookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	query := blockingRows(table, resourceColumn+`, blocked_start, blocked_end`,
+		resourceColumn+` = ANY($1) AND blocked_start < $3 AND blocked_end > $2 AND status <> ALL($4)`, ``)
+	rows, err := s.pgDb.Query(ctx, query, resourceIds, from, to, releasedStatuses)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	defer rows.Close()
+	var bookings []models.Booking
+	for rows.Next() {
+		var booking models.Booking
+		if err := rows.Scan(&booking.ResourceId, &booking.StartTime, &booking.EndTime); err != nil {
+			s.logger.Warn(err)
+			return nil, err
+		}
+		bookings = append(bookings, booking)
+	}
+	return bookings, rows.Err()
 }
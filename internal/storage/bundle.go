@@ -0,0 +1,86 @@
+package storage
+
+import (
+	"context"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"sort"
+)
+
+// bundleBookingTypes are the booking tables a bundle can span.
+var bundleBookingTypes = []string{utills.WorkplaceType, utills.ParkingType, utills.RoomType, utills.PoolType}
+
+// CreateBookingBundle books all items in one transaction, if any of them conflicts or fails
+// check nothing is booked.
+func (s *Storage) CreateBookingBundle(ctx context.Context, userId string, items []models.BundleBooking, check QuotaCheck) (int64, []models.BundleBooking, error) {
+	// Ресурсы блокируются в одном порядке, чтобы встречные наборы не ждали друг друга
+	ordered := make([]models.BundleBooking, len(items))
+	copy(ordered, items)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if ordered[i].BookingType != ordered[j].BookingType {
+			return ordered[i].BookingType < ordered[j].BookingType
+		}
+		return ordered[i].Booking.ResourceId < ordered[j].Booking.ResourceId
+	})
+
+	tx, err := s.pgDb.Begin(ctx)
+	if err != nil {
+		s.logger.Warn(err)
+		return 0, nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	var bundleId int64
+	if err := tx.QueryRow(ctx, `INSERT INTO booking_service.booking_bundles (user_id) VALUES ($1) RETURNING id`, userId).Scan(&bundleId); err != nil {
+		s.logger.Warn(err)
+		return 0, nil, err
+	}
+	created := make([]models.BundleBooking, 0, len(ordered))
+	for _, item := range ordered {
+		table, resourceColumn, err := bookingTable(item.BookingType)
+		if err != nil {
+			s.logger.Warn(err)
+			return 0, nil, err
+		}
+		item.Booking.BundleId = bundleId
+		if err := s.checkQuota(ctx, tx, item.BookingType, item.Booking, check); err != nil {
+			return 0, nil, err
+		}
+		booking, err := s.createBookingTx(ctx, tx, table, resourceColumn, item.Booking)
+		if err != nil {
+			return 0, nil, err
+		}
+		created = append(created, models.BundleBooking{BookingType: item.BookingType, Booking: booking})
+	}
+	if err := tx.Commit(ctx); err != nil {
+		s.logger.Warn(err)
+		return 0, nil, err
+	}
+	return bundleId, created, nil
+}
+
+// CancelBundleBookings cancels the bookings of the bundle whose status is one of statuses.
+func (s *Storage) CancelBundleBookings(ctx context.Context, bundleId int64, statuses []string, cancelledBy, reason string) ([]models.BundleBooking, error) {
+	tx, err := s.pgDb.Begin(ctx)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	cancelled := make([]models.BundleBooking, 0)
+	for _, bookingType := range bundleBookingTypes {
+		bookings, err := s.cancelBookingsTx(ctx, tx, bookingType, `bundle_id = $1 AND status = ANY($2)`, cancelledBy, reason, bundleId, statuses)
+		if err != nil {
+			return nil, err
+		}
+		for _, booking := range bookings {
+			cancelled = append(cancelled, models.BundleBooking{BookingType: bookingType, Booking: booking})
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	return cancelled, nil
+}
@@ -0,0 +1,205 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"time"
+)
+
+// GetOpeningHours returns the weekly opening hours of the zone, empty when the zone has no calendar.
+func (s *Storage) GetOpeningHours(ctx context.Context, zone string) ([]models.OpeningHours, error) {
+	rows, err := s.pgDb.Query(ctx, `SELECT weekday, opens_minute, closes_minute FROM booking_service.opening_hours WHERE zone = $1`, zone)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	defer rows.Close()
+	hours := make([]models.OpeningHours, 0)
+	for rows.Next() {
+		var weekday, opens, closes int64
+		if err := rows.Scan(&weekday, &opens, &closes); err != nil {
+			s.logger.Warn(err)
+			return nil, err
+		}
+		hours = append(hours, models.OpeningHours{
+			Weekday:  time.Weekday(weekday),
+			OpensAt:  time.Duration(opens) * time.Minute,
+			ClosesAt: time.Duration(closes) * time.Minute,
+		})
+	}
+	return hours, rows.Err()
+}
+
+// SetOpeningHours replaces the weekly opening hours of the zone, no hours remove its calendar.
+func (s *Storage) SetOpeningHours(ctx context.Context, zone string, hours []models.OpeningHours) error {
+	tx, err := s.pgDb.Begin(ctx)
+	if err != nil {
+		s.logger.Warn(err)
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `DELETE FROM booking_service.opening_hours WHERE zone = $1`, zone); err != nil {
+		s.logger.Warn(err)
+		return err
+	}
+	query := `INSERT INTO booking_service.opening_hours (zone, weekday, opens_minute, closes_minute) VALUES ($1, $2, $3, $4)`
+	for _, day := range hours {
+		if _, err := tx.Exec(ctx, query, zone, int64(day.Weekday), int64(day.OpensAt/time.Minute), int64(day.ClosesAt/time.Minute)); err != nil {
+			s.logger.Warn(err)
+			return err
+		}
+	}
+	if err := tx.Commit(ctx); err != nil {
+		s.logger.Warn(err)
+		return err
+	}
+	return nil
+}
+
+// GetHolidays returns holidays of the zone and the ones of all zones between from and to.
+func (s *Storage) GetHolidays(ctx context.Context, zone string, from, to time.Time) ([]models.Holiday, error) {
+	query := `SELECT date, name FROM booking_service.holidays WHERE (zone IS NULL OR zone = $1) AND date BETWEEN $2::date AND $3::date ORDER BY date`
+	rows, err := s.pgDb.Query(ctx, query, zone, from.Format(time.DateOnly), to.Format(time.DateOnly))
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	defer rows.Close()
+	holidays := make([]models.Holiday, 0)
+	for rows.Next() {
+		var holiday models.Holiday
+		if err := rows.Scan(&holiday.Date, &holiday.Name); err != nil {
+			s.logger.Warn(err)
+			return nil, err
+		}
+		holidays = append(holidays, holiday)
+	}
+	return holidays, rows.Err()
+}
+
+// CreateHolidays adds holidays to the zone, or to all zones when zone is empty.
+// Dates the zone already has are skipped, the number of added holidays is returned.
+func (s *Storage) CreateHolidays(ctx context.Context, zone string, holidays []models.Holiday) (int64, error) {
+	tx, err := s.pgDb.Begin(ctx)
+	if err != nil {
+		s.logger.Warn(err)
+		return 0, err
+	}
+	defer tx.Rollback(ctx)
+
+	query := `INSERT INTO booking_service.holidays (zone, date, name) VALUES (NULLIF($1, ''), $2::date, $3) ON CONFLICT DO NOTHING`
+	var added int64
+	for _, holiday := range holidays {
+		tag, err := tx.Exec(ctx, query, zone, holiday.Date.Format(time.DateOnly), holiday.Name)
+		if err != nil {
+			s.logger.Warn(err)
+			return 0, err
+		}
+		added += tag.RowsAffected()
+	}
+	if err := tx.Commit(ctx); err != nil {
+		s.logger.Warn(err)
+		return 0, err
+	}
+	return added, nil
+}
+
+const blackoutColumns = "id, booking_type, resource_id, start_date, end_date, reason, created_at"
+
+func (s *Storage) GetBlackouts(ctx context.Context, bookingType string, resourceId int64, from, to time.Time) ([]models.Blackout, error) {
+	query := `SELECT ` + blackoutColumns + ` FROM booking_service.blackouts
+		WHERE booking_type = $1 AND resource_id = $2 AND tstzrange(start_date, end_date) && tstzrange($3, $4) ORDER BY start_date`
+	rows, err := s.pgDb.Query(ctx, query, bookingType, resourceId, from, to)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	defer rows.Close()
+	blackouts := make([]models.Blackout, 0)
+	for rows.Next() {
+		var blackout models.Blackout
+		if err := rows.Scan(&blackout.Id, &blackout.BookingType, &blackout.ResourceId, &blackout.StartTime, &blackout.EndTime, &blackout.Reason, &blackout.CreatedAt); err != nil {
+			s.logger.Warn(err)
+			return nil, err
+		}
+		blackouts = append(blackouts, blackout)
+	}
+	return blackouts, rows.Err()
+}
+
+func (s *Storage) CreateBlackout(ctx context.Context, blackout models.Blackout) (models.Blackout, error) {
+	query := `INSERT INTO booking_service.blackouts (booking_type, resource_id, start_date, end_date, reason) VALUES ($1, $2, $3, $4, $5) RETURNING ` + blackoutColumns
+	var created models.Blackout
+	err := s.pgDb.QueryRow(ctx, query, blackout.BookingType, blackout.ResourceId, blackout.StartTime, blackout.EndTime, blackout.Reason).
+		Scan(&created.Id, &created.BookingType, &created.ResourceId, &created.StartTime, &created.EndTime, &created.Reason, &created.CreatedAt)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.Blackout{}, err
+	}
+	return created, nil
+}
+
+func (s *Storage) DeleteBlackout(ctx context.Context, blackoutId int64) error {
+	tag, err := s.pgDb.Exec(ctx, `DELETE FROM booking_service.blackouts WHERE id = $1`, blackoutId)
+	if err != nil {
+		s.logger.Warn(err)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return utills.ErrNoRows
+	}
+	return nil
+}
+
+// GetZoneTimeZone returns the IANA time zone of the office zone.
+func (s *Storage) GetZoneTimeZone(ctx context.Context, zone string) (string, error) {
+	var timeZone string
+	err := s.pgDb.QueryRow(ctx, `SELECT time_zone FROM booking_service.zone_time_zones WHERE zone = $1`, zone).Scan(&timeZone)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", utills.ErrNoRows
+		}
+		s.logger.Warn(err)
+		return "", err
+	}
+	return timeZone, nil
+}
+
+// GetResourceBuffer returns the buffers configured for the resource itself.
+func (s *Storage) GetResourceBuffer(ctx context.Context, bookingType string, resourceId int64) (models.Buffer, error) {
+	query := `SELECT before_minutes, after_minutes FROM booking_service.resource_buffers WHERE booking_type = $1 AND resource_id = $2`
+	var before, after int64
+	if err := s.pgDb.QueryRow(ctx, query, bookingType, resourceId).Scan(&before, &after); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Buffer{}, utills.ErrNoRows
+		}
+		s.logger.Warn(err)
+		return models.Buffer{}, err
+	}
+	return models.Buffer{Before: time.Duration(before) * time.Minute, After: time.Duration(after) * time.Minute}, nil
+}
+
+// GetResourceBuffers returns the buffers configured for the resources that have their own.
+func (s *Storage) GetResourceBuffers(ctx context.Context, bookingType string, resourceIds []int64) (map[int64]models.Buffer, error) {
+	query := `SELECT resource_id, before_minutes, after_minutes FROM booking_service.resource_buffers WHERE booking_type = $1 AND resource_id = ANY($2)`
+	rows, err := s.pgDb.Query(ctx, query, bookingType, resourceIds)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	defer rows.Close()
+	buffers := make(map[int64]models.Buffer)
+	for rows.Next() {
+		var resourceId, before, after int64
+		if err := rows.Scan(&resourceId, &before, &after); err != nil {
+			s.logger.Warn(err)
+			return nil, err
+		}
+		buffers[resourceId] = models.Buffer{Before: time.Duration(before) * time.Minute, After: time.Duration(after) * time.Minute}
+	}
+	return buffers, rows.Err()
+}
@@ -0,0 +1,147 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"time"
+)
+
+const exclusionViolationCode = "23P01"
+
+// releasedStatuses are statuses of bookings that no longer hold their resource.
+// Keep in sync with the WHERE clause of the exclusion constraints in sql/main.sql.
+var releasedStatuses = []string{utills.StatusNoShow, utills.StatusCancelled, utills.StatusExpired}
+
+const (
+	workplaceBookingsTable = "booking_service.booking"
+	roomBookingsTable      = "booking_service.room_bookings"
+)
+
+func bookingTable(bookingType string) (string, string, error) {
+	switch bookingType {
+	case utills.WorkplaceType:
+		return workplaceBookingsTable, "workplace_id", nil
+	case utills.ParkingType:
+		return "booking_service.parking_bookings", "parking_space_id", nil
+	case utills.RoomType:
+		return roomBookingsTable, "workplace_id", nil
+	case utills.PoolType:
+		return poolBookingsTable, "pool_id", nil
+	default:
+		return "", "", fmt.Errorf("booking type %s not supported", bookingType)
+	}
+}
+
+// sharedTable returns the other table whose bookings hold the same resources, if any. Rooms are
+// workplaces of the resource service, so a desk booking and a room booking of one workplace_id
+// must not overlap, and exclusion constraints do not reach across tables.
+func sharedTable(table string) string {
+	switch table {
+	case workplaceBookingsTable:
+		return roomBookingsTable
+	case roomBookingsTable:
+		return workplaceBookingsTable
+	default:
+		return ""
+	}
+}
+
+// blockingRows selects columns of the bookings holding resources of the table: its own rows
+// matching condition and ownCondition, and the rows of the shared table matching condition.
+func blockingRows(table, columns, condition, ownCondition string) string {
+	query := `SELECT ` + columns + ` FROM ` + table + ` WHERE ` + condition
+	if ownCondition != "" {
+		query += ` AND ` + ownCondition
+	}
+	if other := sharedTable(table); other != "" {
+		query += ` UNION ALL SELECT ` + columns + ` FROM ` + other + ` WHERE ` + condition
+	}
+	return query
+}
+
+// lockResource serializes concurrent writers of the same resource until the end of tx,
+// so the overlap check below cannot race with another insert. Tables sharing resources
+// share the lock.
+func lockResource(ctx context.Context, tx pgx.Tx, table string, resourceId int64) error {
+	if table == roomBookingsTable {
+		table = workplaceBookingsTable
+	}
+	_, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext($1), $2::int)`, table, resourceId)
+	return err
+}
+
+// checkConflict fails when the blocked range [startTime, endTime) of a booking, buffers
+// included, overlaps the blocked range of another booking of the resource.
+func (s *Storage) checkConflict(ctx context.Context, tx pgx.Tx, table, resourceColumn string, resourceId, excludeBookingId int64, startTime, endTime time.Time) error {
+	if table == poolBookingsTable {
+		return s.checkPoolCapacity(ctx, tx, resourceId, excludeBookingId, startTime, endTime)
+	}
+	if err := lockResource(ctx, tx, table, resourceId); err != nil {
+		s.logger.Warn(err)
+		return err
+	}
+	conflictId, err := s.findConflict(ctx, tx, table, resourceColumn, resourceId, excludeBookingId, startTime, endTime)
+	if err != nil || conflictId == 0 {
+		return err
+	}
+	return &utills.BookingConflictError{BookingId: conflictId}
+}
+
+// findConflict returns the id of the first booking blocking the resource within [startTime,
+// endTime), zero when the range is free.
+func (s *Storage) findConflict(ctx context.Context, q querier, table, resourceColumn string, resourceId, excludeBookingId int64, startTime, endTime time.Time) (int64, error) {
+	query := `SELECT id FROM (` + blockingRows(table, `id, blocked_start`,
+		resourceColumn+` = $1 AND tstzrange(blocked_start, blocked_end) && tstzrange($3, $4) AND status <> ALL($5)`, `id <> $2`) + `) blocks
+		ORDER BY blocked_start LIMIT 1`
+	var conflictId int64
+	err := q.QueryRow(ctx, query, resourceId, excludeBookingId, startTime, endTime, releasedStatuses).Scan(&conflictId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		s.logger.Warn(err)
+		return 0, err
+	}
+	return conflictId, nil
+}
+
+// exclusionConflict names the booking an insert or update of [startTime, endTime) ran into when
+// the exclusion constraint caught what checkConflict did not. The violation aborts the
+// transaction, the row it ran into is committed, so it is looked up outside.
+func (s *Storage) exclusionConflict(ctx context.Context, table, resourceColumn string, resourceId, excludeBookingId int64, startTime, endTime time.Time) error {
+	conflictId, err := s.findConflict(ctx, s.pgDb, table, resourceColumn, resourceId, excludeBookingId, startTime, endTime)
+	if err != nil || conflictId == 0 {
+		return utills.ErrBookingConflict
+	}
+	return &utills.BookingConflictError{BookingId: conflictId}
+}
+
+func isExclusionViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == exclusionViolationCode
+}
+
+// GetNextBlockedStart returns the blocked start of the first active booking of the resource
+// that still holds it after the given moment. The excluded booking is ignored.
+func (s *Storage) GetNextBlockedStart(ctx context.Context, bookingType string, resourceId, excludeBookingId int64, after time.Time) (time.Time, error) {
+	table, resourceColumn, err := bookingTable(bookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return time.Time{}, err
+	}
+	query := `SELECT min(blocked_start) FROM (` + blockingRows(table, `blocked_start`,
+		resourceColumn+` = $1 AND blocked_end > $3 AND status <> ALL($4)`, `id <> $2`) + `) blocks`
+	var next *time.Time
+	if err := s.pgDb.QueryRow(ctx, query, resourceId, excludeBookingId, after, releasedStatuses).Scan(&next); err != nil {
+		s.logger.Warn(err)
+		return time.Time{}, err
+	}
+	if next == nil {
+		return time.Time{}, utills.ErrNoRows
+	}
+	return *next, nil
+}
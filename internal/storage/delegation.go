@@ -0,0 +1,81 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+)
+
+const delegationColumns = "id, delegate_id, principal_id, COALESCE(booking_type, ''), created_at"
+
+func scanDelegation(row pgx.Row) (models.Delegation, error) {
+	var delegation models.Delegation
+	err := row.Scan(&delegation.Id, &delegation.DelegateId, &delegation.PrincipalId, &delegation.BookingType, &delegation.CreatedAt)
+	return delegation, err
+}
+
+// CreateDelegation stores the delegation, creating one that already exists returns the stored row.
+func (s *Storage) CreateDelegation(ctx context.Context, delegation models.Delegation) (models.Delegation, error) {
+	query := `INSERT INTO booking_service.delegations (delegate_id, principal_id, booking_type) VALUES ($1, $2, NULLIF($3, ''))
+		ON CONFLICT DO NOTHING RETURNING ` + delegationColumns
+	created, err := scanDelegation(s.pgDb.QueryRow(ctx, query, delegation.DelegateId, delegation.PrincipalId, delegation.BookingType))
+	if errors.Is(err, sql.ErrNoRows) {
+		query = `SELECT ` + delegationColumns + ` FROM booking_service.delegations
+			WHERE delegate_id = $1 AND principal_id = $2 AND COALESCE(booking_type, '') = $3`
+		created, err = scanDelegation(s.pgDb.QueryRow(ctx, query, delegation.DelegateId, delegation.PrincipalId, delegation.BookingType))
+	}
+	if err != nil {
+		s.logger.Warn(err)
+		return models.Delegation{}, err
+	}
+	return created, nil
+}
+
+// DeleteDelegation removes the delegation given by the principal.
+func (s *Storage) DeleteDelegation(ctx context.Context, delegationId int64, principalId string) error {
+	tag, err := s.pgDb.Exec(ctx, `DELETE FROM booking_service.delegations WHERE id = $1 AND principal_id = $2`, delegationId, principalId)
+	if err != nil {
+		s.logger.Warn(err)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return utills.ErrNoRows
+	}
+	return nil
+}
+
+// GetDelegations returns the delegations the user has given and the ones given to the user.
+func (s *Storage) GetDelegations(ctx context.Context, userId string) ([]models.Delegation, error) {
+	query := `SELECT ` + delegationColumns + ` FROM booking_service.delegations WHERE principal_id = $1 OR delegate_id = $1 ORDER BY id`
+	rows, err := s.pgDb.Query(ctx, query, userId)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	defer rows.Close()
+	delegations := make([]models.Delegation, 0)
+	for rows.Next() {
+		delegation, err := scanDelegation(rows)
+		if err != nil {
+			s.logger.Warn(err)
+			return nil, err
+		}
+		delegations = append(delegations, delegation)
+	}
+	return delegations, rows.Err()
+}
+
+// HasDelegation reports whether delegateId may book bookings of the type for principalId.
+func (s *Storage) HasDelegation(ctx context.Context, delegateId, principalId, bookingType string) (bool, error) {
+	query := `SELECT EXISTS (SELECT 1 FROM booking_service.delegations
+		WHERE delegate_id = $1 AND principal_id = $2 AND (booking_type IS NULL OR booking_type = $3))`
+	var exists bool
+	if err := s.pgDb.QueryRow(ctx, query, delegateId, principalId, bookingType).Scan(&exists); err != nil {
+		s.logger.Warn(err)
+		return false, err
+	}
+	return exists, nil
+}
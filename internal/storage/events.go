@@ -0,0 +1,24 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/jackc/pgx/v5"
+	"github.com/pedroxer/booking-service/internal/models"
+)
+
+// addBookingEvent writes the event to the outbox table, notification senders poll it
+// for rows with empty sent_at.
+func (s *Storage) addBookingEvent(ctx context.Context, tx pgx.Tx, event models.BookingEvent) error {
+	payload, err := json.Marshal(event.Payload)
+	if err != nil {
+		s.logger.Warn(err)
+		return err
+	}
+	query := `INSERT INTO booking_service.booking_events (event_type, user_id, booking_type, booking_id, payload) VALUES ($1, $2, $3, $4, $5)`
+	if _, err := tx.Exec(ctx, query, event.EventType, event.UserId, event.BookingType, event.BookingId, payload); err != nil {
+		s.logger.Warn(err)
+		return err
+	}
+	return nil
+}
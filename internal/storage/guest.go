@@ -0,0 +1,228 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"time"
+)
+
+const guestColumns = "guest_name, COALESCE(guest_email, ''), COALESCE(guest_company, ''), check_in_code, checked_in_at"
+
+// GuestCheck decides whether the guest may check in at now. Storage calls it with the guest and
+// the booking rows locked, visit.Booking is the current booking.
+type GuestCheck func(visit models.GuestBooking, now time.Time) error
+
+// guestBookingIds selects the ids of guest bookings of the type given by $n.
+func guestBookingIds(param string) string {
+	return `SELECT booking_id FROM booking_service.booking_guests WHERE booking_type = ` + param
+}
+
+// guestCondition excludes guest bookings of the type given by $n.
+func guestCondition(param string) string {
+	return ` id NOT IN (` + guestBookingIds(param) + `)`
+}
+
+// CreateGuestBooking stores the booking of the host together with the guest and the event that
+// tells the host about the visit: it carries the guest name and the times, never the check-in
+// code. check validates the quota of the host like in CreateBooking.
+func (s *Storage) CreateGuestBooking(ctx context.Context, bookingType string, booking models.Booking, guest models.Guest, event models.BookingEvent, check QuotaCheck) (models.GuestBooking, error) {
+	table, resourceColumn, err := bookingTable(bookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.GuestBooking{}, err
+	}
+
+	tx, err := s.pgDb.Begin(ctx)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.GuestBooking{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := s.checkQuota(ctx, tx, bookingType, booking, check); err != nil {
+		return models.GuestBooking{}, err
+	}
+	created, err := s.createBookingTx(ctx, tx, table, resourceColumn, booking)
+	if err != nil {
+		return models.GuestBooking{}, err
+	}
+	query := `INSERT INTO booking_service.booking_guests (booking_type, booking_id, guest_name, guest_email, guest_company, check_in_code)
+		VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, ''), $6)`
+	if _, err := tx.Exec(ctx, query, bookingType, created.BookingId, guest.Name, guest.Email, guest.Company, guest.CheckInCode); err != nil {
+		s.logger.Warn(err)
+		return models.GuestBooking{}, err
+	}
+	event.BookingId = created.BookingId
+	if err := s.addBookingEvent(ctx, tx, event); err != nil {
+		return models.GuestBooking{}, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		s.logger.Warn(err)
+		return models.GuestBooking{}, err
+	}
+	return models.GuestBooking{BookingType: bookingType, Booking: created, Guest: guest}, nil
+}
+
+// ApproveGuestBooking checks the guest in by the one-time code, check tells whether the code
+// still works.
+func (s *Storage) ApproveGuestBooking(ctx context.Context, code string, check GuestCheck) (models.GuestBooking, error) {
+	tx, err := s.pgDb.Begin(ctx)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.GuestBooking{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	var visit models.GuestBooking
+	var bookingId int64
+	query := `SELECT booking_type, booking_id, ` + guestColumns + ` FROM booking_service.booking_guests WHERE check_in_code = $1 FOR UPDATE`
+	err = tx.QueryRow(ctx, query, code).Scan(&visit.BookingType, &bookingId,
+		&visit.Guest.Name, &visit.Guest.Email, &visit.Guest.Company, &visit.Guest.CheckInCode, &visit.Guest.CheckedInAt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.GuestBooking{}, utills.ErrNoRows
+		}
+		s.logger.Warn(err)
+		return models.GuestBooking{}, err
+	}
+	table, resourceColumn, err := bookingTable(visit.BookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.GuestBooking{}, err
+	}
+
+	current, err := scanBooking(tx.QueryRow(ctx, `SELECT `+bookingColumns(resourceColumn)+` FROM `+table+` WHERE id = $1 FOR UPDATE`, bookingId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.GuestBooking{}, utills.ErrNoRows
+		}
+		s.logger.Warn(err)
+		return models.GuestBooking{}, err
+	}
+	visit.Booking = current
+	now := time.Now()
+	if err := check(visit, now); err != nil {
+		return models.GuestBooking{}, err
+	}
+
+	updateQuery := `UPDATE ` + table + ` SET status = $2, updated_at = now() WHERE id = $1 RETURNING ` + bookingColumns(resourceColumn)
+	visit.Booking, err = scanBooking(tx.QueryRow(ctx, updateQuery, bookingId, utills.StatusConfirmed))
+	if err != nil {
+		s.logger.Warn(err)
+		return models.GuestBooking{}, err
+	}
+	if err := s.addStatusHistory(ctx, tx, visit.BookingType, bookingId, current.Status, visit.Booking.Status); err != nil {
+		return models.GuestBooking{}, err
+	}
+	if _, err := tx.Exec(ctx, `UPDATE booking_service.booking_guests SET checked_in_at = $3 WHERE booking_type = $1 AND booking_id = $2`,
+		visit.BookingType, bookingId, now); err != nil {
+		s.logger.Warn(err)
+		return models.GuestBooking{}, err
+	}
+	visit.Guest.CheckedInAt = &now
+	if err := tx.Commit(ctx); err != nil {
+		s.logger.Warn(err)
+		return models.GuestBooking{}, err
+	}
+	return visit, nil
+}
+
+// ExpireGuestBookings moves pending guest bookings that ended before deadline to EXPIRED,
+// the guest never came and the check-in code is no longer valid.
+func (s *Storage) ExpireGuestBookings(ctx context.Context, bookingType string, deadline time.Time) ([]models.Booking, error) {
+	table, resourceColumn, err := bookingTable(bookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+
+	tx, err := s.pgDb.Begin(ctx)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	bookings, err := s.queryBookings(ctx, tx, expireGuestsQuery(table, resourceColumn), utills.StatusExpired, utills.StatusPending, deadline, bookingType)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.addStatusHistoryBatch(ctx, tx, bookingType, bookings, utills.StatusPending, utills.StatusExpired); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	return bookings, nil
+}
+
+// expireGuestsQuery expires pending guest bookings, the parameters are the new and the pending
+// status, the deadline and the booking type.
+func expireGuestsQuery(table, resourceColumn string) string {
+	return `UPDATE ` + table + ` SET status = $1, updated_at = now() WHERE status = $2 AND end_date <= $3
+		AND id IN (` + guestBookingIds("$4") + `) RETURNING ` + bookingColumns(resourceColumn)
+}
+
+// GetGuestBookings returns guest bookings of the type overlapping [from, to), cancelled ones included.
+func (s *Storage) GetGuestBookings(ctx context.Context, bookingType string, from, to time.Time) ([]models.GuestBooking, error) {
+	table, resourceColumn, err := bookingTable(bookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	query := `SELECT ` + bookingColumns(resourceColumn) + `, ` + guestColumns + ` FROM ` + table + `
+		JOIN booking_service.booking_guests g ON g.booking_type = $1 AND g.booking_id = id
+		WHERE start_date < $3 AND end_date > $2 ORDER BY start_date, id`
+	rows, err := s.pgDb.Query(ctx, query, bookingType, from, to)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	defer rows.Close()
+	visits := make([]models.GuestBooking, 0)
+	for rows.Next() {
+		visit, err := scanGuestBooking(rows)
+		if err != nil {
+			s.logger.Warn(err)
+			return nil, err
+		}
+		visit.BookingType = bookingType
+		visits = append(visits, visit)
+	}
+	return visits, rows.Err()
+}
+
+func scanGuestBooking(row pgx.Row) (models.GuestBooking, error) {
+	var visit models.GuestBooking
+	booking := &visit.Booking
+	guest := &visit.Guest
+	err := row.Scan(&booking.BookingId,
+		&booking.UserId,
+		&booking.ResourceId,
+		&booking.StartTime,
+		&booking.EndTime,
+		&booking.Status,
+		&booking.SeriesId,
+		&booking.CreatedAt,
+		&booking.UpdatedAt,
+		&booking.LicencePlate,
+		&booking.CancelledAt,
+		&booking.CancelledBy,
+		&booking.CancelReason,
+		&booking.BundleId,
+		&booking.TimeZone,
+		&booking.BlockedStart,
+		&booking.BlockedEnd,
+		&booking.BookedBy,
+		&guest.Name,
+		&guest.Email,
+		&guest.Company,
+		&guest.CheckInCode,
+		&guest.CheckedInAt)
+	return visit, err
+}
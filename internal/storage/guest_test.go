@@ -0,0 +1,34 @@
+package storage
+
+import (
+	"github.com/pedroxer/booking-service/internal/utills"
+	"strings"
+	"testing"
+)
+
+// The queries are checked as text, the tests have no database to run them on.
+func TestGuestQueries(t *testing.T) {
+	for _, bookingType := range []string{utills.WorkplaceType, utills.ParkingType, utills.RoomType} {
+		t.Run(bookingType, func(t *testing.T) {
+			table, resourceColumn, err := bookingTable(bookingType)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// MarkNoShowBookings binds the booking type to $5.
+			noShow := noShowQuery(table, resourceColumn)
+			if !strings.Contains(noShow, "AND id NOT IN ("+guestBookingIds("$5")+")") {
+				t.Errorf("no-show query does not skip guest bookings: %s", noShow)
+			}
+
+			// ExpireGuestBookings binds the booking type to $4.
+			expire := expireGuestsQuery(table, resourceColumn)
+			if !strings.Contains(expire, "AND id IN ("+guestBookingIds("$4")+")") || strings.Contains(expire, "NOT IN") {
+				t.Errorf("expire query does not select guest bookings only: %s", expire)
+			}
+			if !strings.Contains(expire, "end_date <= $3") {
+				t.Errorf("expire query does not wait for the end of the visit: %s", expire)
+			}
+		})
+	}
+}
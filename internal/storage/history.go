@@ -0,0 +1,39 @@
+package storage
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5"
+	"github.com/pedroxer/booking-service/internal/models"
+)
+
+// TransitionCheck validates a status change of a booking. Storage calls it with the booking
+// row locked, so the status it is given cannot change before the update.
+type TransitionCheck func(from, to string) error
+
+func (s *Storage) addStatusHistory(ctx context.Context, tx pgx.Tx, bookingType string, bookingId int64, from, to string) error {
+	query := `INSERT INTO booking_service.booking_status_history (booking_id, booking_type, from_status, to_status) VALUES ($1, $2, $3, $4)`
+	if _, err := tx.Exec(ctx, query, bookingId, bookingType, from, to); err != nil {
+		s.logger.Warn(err)
+		return err
+	}
+	return nil
+}
+
+func (s *Storage) addStatusHistoryBatch(ctx context.Context, tx pgx.Tx, bookingType string, bookings []models.Booking, from, to string) error {
+	for _, booking := range bookings {
+		if err := s.addStatusHistory(ctx, tx, bookingType, booking.BookingId, from, to); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addChangeHistory records a move to another resource or a transfer to another user.
+func (s *Storage) addChangeHistory(ctx context.Context, tx pgx.Tx, bookingType string, bookingId int64, change, from, to, changedBy string) error {
+	query := `INSERT INTO booking_service.booking_change_history (booking_id, booking_type, change, from_value, to_value, changed_by) VALUES ($1, $2, $3, $4, $5, $6)`
+	if _, err := tx.Exec(ctx, query, bookingId, bookingType, change, from, to, changedBy); err != nil {
+		s.logger.Warn(err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"time"
+)
+
+// GetPolicy returns the policy stored for the booking type together with its zone groups.
+func (s *Storage) GetPolicy(ctx context.Context, bookingType string) (models.Policy, error) {
+	query := `SELECT max_duration_minutes, max_advance_days, min_lead_minutes, max_active_bookings, max_days_per_week
+		FROM booking_service.policies WHERE booking_type = $1`
+	var maxDuration, maxAdvance, minLead int64
+	var policy models.Policy
+	err := s.pgDb.QueryRow(ctx, query, bookingType).Scan(&maxDuration, &maxAdvance, &minLead, &policy.MaxActiveBookings, &policy.MaxDaysPerWeek)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Policy{}, utills.ErrNoRows
+		}
+		s.logger.Warn(err)
+		return models.Policy{}, err
+	}
+	policy.MaxDuration = time.Duration(maxDuration) * time.Minute
+	policy.MaxAdvance = time.Duration(maxAdvance) * 24 * time.Hour
+	policy.MinLeadTime = time.Duration(minLead) * time.Minute
+
+	rows, err := s.pgDb.Query(ctx, `SELECT zone, group_name FROM booking_service.policy_zone_groups WHERE booking_type = $1`, bookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.Policy{}, err
+	}
+	defer rows.Close()
+	policy.ZoneGroups = make(map[string][]string)
+	for rows.Next() {
+		var zone, group string
+		if err := rows.Scan(&zone, &group); err != nil {
+			s.logger.Warn(err)
+			return models.Policy{}, err
+		}
+		policy.ZoneGroups[zone] = append(policy.ZoneGroups[zone], group)
+	}
+	return policy, rows.Err()
+}
+
+func (s *Storage) GetUserGroups(ctx context.Context, userId string) ([]string, error) {
+	rows, err := s.pgDb.Query(ctx, `SELECT group_name FROM booking_service.user_groups WHERE user_id = $1`, userId)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	defer rows.Close()
+	groups := make([]string, 0)
+	for rows.Next() {
+		var group string
+		if err := rows.Scan(&group); err != nil {
+			s.logger.Warn(err)
+			return nil, err
+		}
+		groups = append(groups, group)
+	}
+	return groups, rows.Err()
+}
+
+// UserBookings reads the bookings a user already holds, for the limits on their number.
+type UserBookings interface {
+	CountActiveBookings(ctx context.Context, bookingType, userId string) (int64, error)
+	GetUserBookingStarts(ctx context.Context, bookingType, userId string, from, to time.Time, excludeBookingId int64) ([]time.Time, error)
+}
+
+// QuotaCheck validates a new booking against the bookings its user already holds. Storage runs
+// it in the inserting transaction with the user locked, so parallel requests of the user
+// cannot both pass the check and take the last place.
+type QuotaCheck func(ctx context.Context, bookingType string, booking models.Booking, held UserBookings) error
+
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// heldInTx reads the bookings of the user inside the transaction, bookings it inserted included.
+type heldInTx struct {
+	s  *Storage
+	tx pgx.Tx
+}
+
+func (h heldInTx) CountActiveBookings(ctx context.Context, bookingType, userId string) (int64, error) {
+	return h.s.countActiveBookings(ctx, h.tx, bookingType, userId)
+}
+
+func (h heldInTx) GetUserBookingStarts(ctx context.Context, bookingType, userId string, from, to time.Time, excludeBookingId int64) ([]time.Time, error) {
+	return h.s.getUserBookingStarts(ctx, h.tx, bookingType, userId, from, to, excludeBookingId)
+}
+
+// checkQuota locks the user of the booking until the end of the transaction and runs check,
+// a nil check is skipped.
+func (s *Storage) checkQuota(ctx context.Context, tx pgx.Tx, bookingType string, booking models.Booking, check QuotaCheck) error {
+	if check == nil {
+		return nil
+	}
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext($1))`, "user:"+booking.UserId); err != nil {
+		s.logger.Warn(err)
+		return err
+	}
+	return check(ctx, bookingType, booking, heldInTx{s: s, tx: tx})
+}
+
+// CountActiveBookings counts bookings of the user that have not ended and still hold their resource.
+func (s *Storage) CountActiveBookings(ctx context.Context, bookingType, userId string) (int64, error) {
+	return s.countActiveBookings(ctx, s.pgDb, bookingType, userId)
+}
+
+func (s *Storage) countActiveBookings(ctx context.Context, q querier, bookingType, userId string) (int64, error) {
+	table, _, err := bookingTable(bookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return 0, err
+	}
+	query := `SELECT count(*) FROM ` + table + ` WHERE user_id = $1 AND end_date > now() AND status <> ALL($2) AND status <> $3`
+	var count int64
+	if err := q.QueryRow(ctx, query, userId, releasedStatuses, utills.StatusDone).Scan(&count); err != nil {
+		s.logger.Warn(err)
+		return 0, err
+	}
+	return count, nil
+}
+
+// GetUserBookingStarts returns start times of the user's bookings starting in [from, to),
+// except the excluded one, for counting booked days.
+func (s *Storage) GetUserBookingStarts(ctx context.Context, bookingType, userId string, from, to time.Time, excludeBookingId int64) ([]time.Time, error) {
+	return s.getUserBookingStarts(ctx, s.pgDb, bookingType, userId, from, to, excludeBookingId)
+}
+
+func (s *Storage) getUserBookingStarts(ctx context.Context, q querier, bookingType, userId string, from, to time.Time, excludeBookingId int64) ([]time.Time, error) {
+	table, _, err := bookingTable(bookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	query := `SELECT start_date FROM ` + table + ` WHERE user_id = $1 AND start_date >= $2 AND start_date < $3 AND id <> $4 AND status <> ALL($5)`
+	rows, err := q.Query(ctx, query, userId, from, to, excludeBookingId, releasedStatuses)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	defer rows.Close()
+	starts := make([]time.Time, 0)
+	for rows.Next() {
+		var start time.Time
+		if err := rows.Scan(&start); err != nil {
+			s.logger.Warn(err)
+			return nil, err
+		}
+		starts = append(starts, start)
+	}
+	return starts, rows.Err()
+}
@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"time"
+)
+
+const poolBookingsTable = "booking_service.pool_bookings"
+
+const poolColumns = "id, name, zone, floor, resource_type, capacity, is_available"
+
+func scanPool(row pgx.Row) (models.ResourcePool, error) {
+	var pool models.ResourcePool
+	err := row.Scan(&pool.Id, &pool.Name, &pool.Zone, &pool.Floor, &pool.ResourceType, &pool.Capacity, &pool.IsAvailable)
+	return pool, err
+}
+
+func (s *Storage) GetPool(ctx context.Context, poolId int64) (models.ResourcePool, error) {
+	pool, err := scanPool(s.pgDb.QueryRow(ctx, `SELECT `+poolColumns+` FROM booking_service.resource_pools WHERE id = $1`, poolId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.ResourcePool{}, utills.ErrNoRows
+		}
+		s.logger.Warn(err)
+		return models.ResourcePool{}, err
+	}
+	return pool, nil
+}
+
+func (s *Storage) GetPools(ctx context.Context, zone string) ([]models.ResourcePool, error) {
+	rows, err := s.pgDb.Query(ctx, `SELECT `+poolColumns+` FROM booking_service.resource_pools WHERE $1 = '' OR zone = $1 ORDER BY zone, floor, id`, zone)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	defer rows.Close()
+	var pools []models.ResourcePool
+	for rows.Next() {
+		pool, err := scanPool(rows)
+		if err != nil {
+			s.logger.Warn(err)
+			return nil, err
+		}
+		pools = append(pools, pool)
+	}
+	return pools, rows.Err()
+}
+
+// checkPoolCapacity makes sure one more booking fits into the pool at every instant of
+// [startTime, endTime). The bookings are swept in time order: +1 at start, -1 at end, ends
+// first on ties since ranges are half-open, and the peak is compared with the capacity.
+func (s *Storage) checkPoolCapacity(ctx context.Context, tx pgx.Tx, poolId, excludeBookingId int64, startTime, endTime time.Time) error {
+	if err := lockResource(ctx, tx, poolBookingsTable, poolId); err != nil {
+		s.logger.Warn(err)
+		return err
+	}
+	var capacity int64
+	if err := tx.QueryRow(ctx, `SELECT capacity FROM booking_service.resource_pools WHERE id = $1`, poolId).Scan(&capacity); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return utills.ErrNoRows
+		}
+		s.logger.Warn(err)
+		return err
+	}
+	query := `WITH overlapping AS (
+			SELECT GREATEST(blocked_start, $2) AS start_date, LEAST(blocked_end, $3) AS end_date FROM ` + poolBookingsTable + `
+			WHERE pool_id = $1 AND id <> $4 AND tstzrange(blocked_start, blocked_end) && tstzrange($2, $3) AND status <> ALL($5)
+		), events AS (
+			SELECT start_date AS at, 1 AS delta FROM overlapping
+			UNION ALL
+			SELECT end_date, -1 FROM overlapping
+		)
+		SELECT COALESCE(max(taken), 0) FROM (SELECT sum(delta) OVER (ORDER BY at, delta) AS taken FROM events) sweep`
+	var peak int64
+	if err := tx.QueryRow(ctx, query, poolId, startTime, endTime, excludeBookingId, releasedStatuses).Scan(&peak); err != nil {
+		s.logger.Warn(err)
+		return err
+	}
+	if peak >= capacity {
+		return fmt.Errorf("%w: %d of %d taken", utills.ErrPoolFull, peak, capacity)
+	}
+	return nil
+}
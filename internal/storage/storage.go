@@ -2,14 +2,14 @@ package storage
 
 import (
 	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
-	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/pedroxer/booking-service/internal/config"
 	"github.com/pedroxer/booking-service/internal/database"
 	log "github.com/sirupsen/logrus"
 )
 
 type Storage struct {
-	pgDb    *pgx.Conn
+	pgDb    *pgxpool.Pool
 	clickDb driver.Conn
 	logger  *log.Logger
 }
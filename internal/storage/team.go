@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"context"
+	"github.com/pedroxer/booking-service/internal/models"
+	"sort"
+)
+
+// CreateTeamBooking books the resources for all team members in one transaction, if any of the
+// bookings conflicts or fails check nothing is booked. The bookings are returned in the given order.
+func (s *Storage) CreateTeamBooking(ctx context.Context, bookingType string, bookings []models.Booking, check QuotaCheck) ([]models.Booking, error) {
+	table, resourceColumn, err := bookingTable(bookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	// Ресурсы блокируются в одном порядке, как и в наборах
+	ordered := make([]int, len(bookings))
+	for i := range ordered {
+		ordered[i] = i
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return bookings[ordered[i]].ResourceId < bookings[ordered[j]].ResourceId
+	})
+
+	tx, err := s.pgDb.Begin(ctx)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+
+	created := make([]models.Booking, len(bookings))
+	for _, i := range ordered {
+		item := bookings[i]
+		if err := s.checkQuota(ctx, tx, bookingType, item, check); err != nil {
+			return nil, err
+		}
+		booking, err := s.createBookingTx(ctx, tx, table, resourceColumn, item)
+		if err != nil {
+			return nil, err
+		}
+		created[i] = booking
+	}
+	if err := tx.Commit(ctx); err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	return created, nil
+}
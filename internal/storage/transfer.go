@@ -0,0 +1,271 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"strconv"
+)
+
+const transferColumns = "id, booking_type, booking_id, from_user_id, to_user_id, status, created_at"
+
+func scanTransfer(row pgx.Row) (models.BookingTransfer, error) {
+	var transfer models.BookingTransfer
+	err := row.Scan(&transfer.Id,
+		&transfer.BookingType,
+		&transfer.BookingId,
+		&transfer.FromUserId,
+		&transfer.ToUserId,
+		&transfer.Status,
+		&transfer.CreatedAt)
+	return transfer, err
+}
+
+// lockOwnBooking locks the booking row and checks that it is active and that userId owns it.
+func (s *Storage) lockOwnBooking(ctx context.Context, tx pgx.Tx, table, resourceColumn string, bookingId int64, userId string) (models.Booking, error) {
+	current, err := scanBooking(tx.QueryRow(ctx, `SELECT `+bookingColumns(resourceColumn)+` FROM `+table+` WHERE id = $1 FOR UPDATE`, bookingId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Booking{}, utills.ErrNoRows
+		}
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	if current.UserId != userId {
+		return models.Booking{}, utills.ErrNotBookingOwner
+	}
+	if current.Status != utills.StatusPending && current.Status != utills.StatusConfirmed {
+		return models.Booking{}, utills.ErrBookingNotActive
+	}
+	return current, nil
+}
+
+// MoveBooking puts the booking of ownerId on another resource keeping its id and times. The booking
+// is blocked on the new resource with the given buffer and gets the time zone of its office.
+func (s *Storage) MoveBooking(ctx context.Context, bookingType string, bookingId int64, ownerId, changedBy string, resourceId int64, buffer models.Buffer, timeZone string) (models.Booking, error) {
+	table, resourceColumn, err := bookingTable(bookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+
+	tx, err := s.pgDb.Begin(ctx)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	current, err := s.lockOwnBooking(ctx, tx, table, resourceColumn, bookingId, ownerId)
+	if err != nil {
+		return models.Booking{}, err
+	}
+	if current.ResourceId == resourceId {
+		return current, nil
+	}
+	blockedStart := current.StartTime.Add(-buffer.Before)
+	blockedEnd := current.EndTime.Add(buffer.After)
+	if err := s.checkConflict(ctx, tx, table, resourceColumn, resourceId, bookingId, blockedStart, blockedEnd); err != nil {
+		return models.Booking{}, err
+	}
+	query := `UPDATE ` + table + ` SET ` + resourceColumn + ` = $2, blocked_start = $3, blocked_end = $4, time_zone = NULLIF($5, ''), updated_at = now()
+		WHERE id = $1 RETURNING ` + bookingColumns(resourceColumn)
+	booking, err := scanBooking(tx.QueryRow(ctx, query, bookingId, resourceId, blockedStart, blockedEnd, timeZone))
+	if err != nil {
+		if isExclusionViolation(err) {
+			return models.Booking{}, s.exclusionConflict(ctx, table, resourceColumn, resourceId, bookingId, blockedStart, blockedEnd)
+		}
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	if err := s.addChangeHistory(ctx, tx, bookingType, bookingId, utills.ClickStatusMoved,
+		strconv.FormatInt(current.ResourceId, 10), strconv.FormatInt(resourceId, 10), changedBy); err != nil {
+		return models.Booking{}, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	return booking, nil
+}
+
+// TransferBooking hands the booking of fromUserId over to toUserId right away, check runs on the
+// booking as the recipient's new one. Nobody has booked it for the recipient, booked_by is cleared.
+func (s *Storage) TransferBooking(ctx context.Context, bookingType string, bookingId int64, fromUserId, toUserId, changedBy string, check QuotaCheck) (models.Booking, error) {
+	table, resourceColumn, err := bookingTable(bookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+
+	tx, err := s.pgDb.Begin(ctx)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	booking, err := s.transferBookingTx(ctx, tx, bookingType, table, resourceColumn, bookingId, fromUserId, toUserId, changedBy, "", check)
+	if err != nil {
+		return models.Booking{}, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	return booking, nil
+}
+
+// transferBookingTx changes the owner of the booking and who booked it for them, an offer to
+// transfer it that is still waiting is no longer valid.
+func (s *Storage) transferBookingTx(ctx context.Context, tx pgx.Tx, bookingType, table, resourceColumn string, bookingId int64, fromUserId, toUserId, changedBy, bookedBy string, check QuotaCheck) (models.Booking, error) {
+	current, err := s.lockOwnBooking(ctx, tx, table, resourceColumn, bookingId, fromUserId)
+	if err != nil {
+		return models.Booking{}, err
+	}
+	current.UserId = toUserId
+	current.BookedBy = bookedBy
+	if err := s.checkQuota(ctx, tx, bookingType, current, check); err != nil {
+		return models.Booking{}, err
+	}
+	query := `UPDATE ` + table + ` SET user_id = $2, booked_by = NULLIF($3, ''), updated_at = now() WHERE id = $1 RETURNING ` + bookingColumns(resourceColumn)
+	booking, err := scanBooking(tx.QueryRow(ctx, query, bookingId, toUserId, bookedBy))
+	if err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	if err := s.cancelPendingTransfers(ctx, tx, bookingType, bookingId); err != nil {
+		return models.Booking{}, err
+	}
+	if err := s.addChangeHistory(ctx, tx, bookingType, bookingId, utills.ClickStatusTransferred, fromUserId, toUserId, changedBy); err != nil {
+		return models.Booking{}, err
+	}
+	return booking, nil
+}
+
+func (s *Storage) cancelPendingTransfers(ctx context.Context, tx pgx.Tx, bookingType string, bookingId int64) error {
+	query := `UPDATE booking_service.booking_transfers SET status = $3, responded_at = now() WHERE booking_type = $1 AND booking_id = $2 AND status = $4`
+	if _, err := tx.Exec(ctx, query, bookingType, bookingId, utills.TransferCancelled, utills.TransferPending); err != nil {
+		s.logger.Warn(err)
+		return err
+	}
+	return nil
+}
+
+// CreateTransferOffer stores an offer to transfer the booking and notifies the recipient.
+// A booking has at most one waiting offer, a new one replaces the previous.
+func (s *Storage) CreateTransferOffer(ctx context.Context, transfer models.BookingTransfer, event models.BookingEvent) (models.BookingTransfer, error) {
+	table, resourceColumn, err := bookingTable(transfer.BookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.BookingTransfer{}, err
+	}
+
+	tx, err := s.pgDb.Begin(ctx)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.BookingTransfer{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := s.lockOwnBooking(ctx, tx, table, resourceColumn, transfer.BookingId, transfer.FromUserId); err != nil {
+		return models.BookingTransfer{}, err
+	}
+	if err := s.cancelPendingTransfers(ctx, tx, transfer.BookingType, transfer.BookingId); err != nil {
+		return models.BookingTransfer{}, err
+	}
+	query := `INSERT INTO booking_service.booking_transfers (booking_type, booking_id, from_user_id, to_user_id, status)
+		VALUES ($1, $2, $3, $4, $5) RETURNING ` + transferColumns
+	created, err := scanTransfer(tx.QueryRow(ctx, query, transfer.BookingType, transfer.BookingId, transfer.FromUserId, transfer.ToUserId, utills.TransferPending))
+	if err != nil {
+		s.logger.Warn(err)
+		return models.BookingTransfer{}, err
+	}
+	if event.Payload == nil {
+		event.Payload = map[string]string{}
+	}
+	event.Payload["transfer_id"] = strconv.FormatInt(created.Id, 10)
+	if err := s.addBookingEvent(ctx, tx, event); err != nil {
+		return models.BookingTransfer{}, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		s.logger.Warn(err)
+		return models.BookingTransfer{}, err
+	}
+	return created, nil
+}
+
+func (s *Storage) GetTransfer(ctx context.Context, transferId int64) (models.BookingTransfer, error) {
+	transfer, err := scanTransfer(s.pgDb.QueryRow(ctx, `SELECT `+transferColumns+` FROM booking_service.booking_transfers WHERE id = $1`, transferId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.BookingTransfer{}, utills.ErrNoRows
+		}
+		s.logger.Warn(err)
+		return models.BookingTransfer{}, err
+	}
+	return transfer, nil
+}
+
+// RespondToTransfer stores the answer of the recipient userId to an offer, check tells whether the
+// offer can still be answered. When the offer is accepted the booking changes its owner in the
+// same transaction, after quota passes for the recipient.
+func (s *Storage) RespondToTransfer(ctx context.Context, transferId int64, userId, changedBy string, accept bool, check TransitionCheck, quota QuotaCheck) (models.BookingTransfer, models.Booking, error) {
+	tx, err := s.pgDb.Begin(ctx)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.BookingTransfer{}, models.Booking{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	transfer, err := scanTransfer(tx.QueryRow(ctx, `SELECT `+transferColumns+` FROM booking_service.booking_transfers WHERE id = $1 FOR UPDATE`, transferId))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.BookingTransfer{}, models.Booking{}, utills.ErrNoRows
+		}
+		s.logger.Warn(err)
+		return models.BookingTransfer{}, models.Booking{}, err
+	}
+	if transfer.ToUserId != userId {
+		return models.BookingTransfer{}, models.Booking{}, utills.ErrNoRows
+	}
+	status := utills.TransferDeclined
+	if accept {
+		status = utills.TransferAccepted
+	}
+	if err := check(transfer.Status, status); err != nil {
+		return models.BookingTransfer{}, models.Booking{}, err
+	}
+
+	var booking models.Booking
+	if accept {
+		table, resourceColumn, err := bookingTable(transfer.BookingType)
+		if err != nil {
+			s.logger.Warn(err)
+			return models.BookingTransfer{}, models.Booking{}, err
+		}
+		// Делегат, принявший бронь за получателя, становится тем, кто её забронировал
+		bookedBy := changedBy
+		if bookedBy == transfer.ToUserId {
+			bookedBy = ""
+		}
+		// Передача отменяет ожидающие предложения, в том числе это, поэтому статус пишется после
+		booking, err = s.transferBookingTx(ctx, tx, transfer.BookingType, table, resourceColumn, transfer.BookingId, transfer.FromUserId, transfer.ToUserId, changedBy, bookedBy, quota)
+		if err != nil {
+			return models.BookingTransfer{}, models.Booking{}, err
+		}
+	}
+	transfer.Status = status
+	if _, err := tx.Exec(ctx, `UPDATE booking_service.booking_transfers SET status = $2, responded_at = now() WHERE id = $1`, transferId, transfer.Status); err != nil {
+		s.logger.Warn(err)
+		return models.BookingTransfer{}, models.Booking{}, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		s.logger.Warn(err)
+		return models.BookingTransfer{}, models.Booking{}, err
+	}
+	return transfer, booking, nil
+}
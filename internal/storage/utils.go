@@ -38,17 +38,19 @@ func GenerateLimits(page, pageSize int64) string {
 	return fmt.Sprintf(" OFFSET %d LIMIT %d", (page-1)*pageSize, pageSize)
 }
 
-func GenerateUpdates(columns map[string]SearchField, updateFields []Field) (string, error) {
+func GenerateUpdates(columns map[string]SearchField, updateFields []Field, argOffset int) (string, []interface{}, error) {
 	var updates strings.Builder
+	args := make([]interface{}, 0, len(updateFields))
 	andPrefix := ""
 	for _, column := range updateFields {
 		var ok bool
 		var field SearchField
 		if field, ok = columns[column.Name]; !ok {
-			return "", fmt.Errorf("bad update by column %s", column.Name)
+			return "", nil, fmt.Errorf("bad update by column %s", column.Name)
 		}
-		updates.WriteString(fmt.Sprintf("%s %s = '%s'\n", andPrefix, field.NameWhere, fmt.Sprint(column.Value)))
+		args = append(args, column.Value)
+		updates.WriteString(fmt.Sprintf("%s %s = $%d\n", andPrefix, field.NameWhere, argOffset+len(args)))
 		andPrefix = ", "
 	}
-	return updates.String(), nil
+	return updates.String(), args, nil
 }
@@ -0,0 +1,189 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/jackc/pgx/v5"
+	"github.com/pedroxer/booking-service/internal/models"
+	"github.com/pedroxer/booking-service/internal/utills"
+	"strings"
+	"time"
+)
+
+const waitlistColumns = "id, user_id, booking_type, COALESCE(resource_id, 0), zone, floor, start_date, end_date, priority, status, COALESCE(booking_id, 0), created_at, updated_at"
+
+func scanWaitlistEntry(row pgx.Row) (models.WaitlistEntry, error) {
+	var entry models.WaitlistEntry
+	err := row.Scan(&entry.Id,
+		&entry.UserId,
+		&entry.BookingType,
+		&entry.ResourceId,
+		&entry.Zone,
+		&entry.Floor,
+		&entry.StartTime,
+		&entry.EndTime,
+		&entry.Priority,
+		&entry.Status,
+		&entry.BookingId,
+		&entry.CreatedAt,
+		&entry.UpdatedAt)
+	return entry, err
+}
+
+func (s *Storage) CreateWaitlistEntry(ctx context.Context, entry models.WaitlistEntry) (models.WaitlistEntry, error) {
+	query := `INSERT INTO booking_service.waitlist (user_id, booking_type, resource_id, zone, floor, start_date, end_date, priority, status)
+		VALUES ($1, $2, NULLIF($3, 0), $4, $5, $6, $7, $8, $9) RETURNING ` + waitlistColumns
+	created, err := scanWaitlistEntry(s.pgDb.QueryRow(ctx, query,
+		entry.UserId,
+		entry.BookingType,
+		entry.ResourceId,
+		entry.Zone,
+		entry.Floor,
+		entry.StartTime,
+		entry.EndTime,
+		entry.Priority,
+		utills.WaitlistWaiting))
+	if err != nil {
+		s.logger.Warn(err)
+		return models.WaitlistEntry{}, err
+	}
+	return created, nil
+}
+
+func (s *Storage) LeaveWaitlist(ctx context.Context, entryId int64, userId string) error {
+	query := `UPDATE booking_service.waitlist SET status = $3, updated_at = now() WHERE id = $1 AND user_id = $2 AND status = $4`
+	tag, err := s.pgDb.Exec(ctx, query, entryId, userId, utills.WaitlistLeft, utills.WaitlistWaiting)
+	if err != nil {
+		s.logger.Warn(err)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return utills.ErrNoRows
+	}
+	return nil
+}
+
+func (s *Storage) GetWaitlist(ctx context.Context, filters []Field, page int64) ([]models.WaitlistEntry, int64, error) {
+	var waitlistColumnsFields = map[string]SearchField{
+		"user_id":      {NameWhere: "user_id", NameOrder: "user_id"},
+		"booking_type": {NameWhere: "booking_type", NameOrder: "booking_type"},
+		"resource_id":  {NameWhere: "resource_id", NameOrder: "resource_id"},
+		"zone":         {NameWhere: "zone", NameOrder: "zone"},
+		"status":       {NameWhere: "status", NameOrder: "status"},
+	}
+
+	where, err := GenerateSearch(waitlistColumnsFields, filters)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, 0, err
+	}
+	var conditions strings.Builder
+	if len(filters) > 0 {
+		conditions.WriteString(" WHERE")
+		conditions.WriteString(where)
+	}
+
+	selectQuery := `SELECT ` + waitlistColumns + ` FROM booking_service.waitlist` + conditions.String() +
+		` ORDER BY priority DESC, created_at` + GenerateLimits(page, utills.PageSize)
+	rows, err := s.pgDb.Query(ctx, selectQuery)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, 0, err
+	}
+	defer rows.Close()
+	var entries []models.WaitlistEntry
+	for rows.Next() {
+		entry, err := scanWaitlistEntry(rows)
+		if err != nil {
+			s.logger.Warn(err)
+			return nil, 0, err
+		}
+		entries = append(entries, entry)
+	}
+
+	var count int64
+	if err := s.pgDb.QueryRow(ctx, `SELECT count(*) FROM booking_service.waitlist`+conditions.String()).Scan(&count); err != nil {
+		s.logger.Warn(err)
+		return nil, 0, err
+	}
+	return entries, count, nil
+}
+
+// GetWaitlistCandidates returns waiting entries that overlap the freed window and want either
+// this resource or any resource of its zone (and floor), in promotion order.
+func (s *Storage) GetWaitlistCandidates(ctx context.Context, bookingType string, resource models.Resource, startTime, endTime time.Time) ([]models.WaitlistEntry, error) {
+	query := `SELECT ` + waitlistColumns + ` FROM booking_service.waitlist
+		WHERE booking_type = $1 AND status = $2
+		AND (resource_id = $3 OR (resource_id IS NULL AND zone = $4 AND (floor = 0 OR floor = $5)))
+		AND start_date < $7 AND end_date > $6 AND start_date > now()
+		ORDER BY priority DESC, created_at`
+	rows, err := s.pgDb.Query(ctx, query, bookingType, utills.WaitlistWaiting, resource.Id, resource.Zone, resource.Floor, startTime, endTime)
+	if err != nil {
+		s.logger.Warn(err)
+		return nil, err
+	}
+	defer rows.Close()
+	var entries []models.WaitlistEntry
+	for rows.Next() {
+		entry, err := scanWaitlistEntry(rows)
+		if err != nil {
+			s.logger.Warn(err)
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, rows.Err()
+}
+
+// PromoteWaitlistEntry books the resource for the waiting user, marks the entry as promoted
+// and stores the notification event in one transaction. check validates the quota of the user
+// like in CreateBooking.
+func (s *Storage) PromoteWaitlistEntry(ctx context.Context, bookingType string, entryId int64, booking models.Booking, event models.BookingEvent, check QuotaCheck) (models.Booking, error) {
+	table, resourceColumn, err := bookingTable(bookingType)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+
+	tx, err := s.pgDb.Begin(ctx)
+	if err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	defer tx.Rollback(ctx)
+
+	var status string
+	if err := tx.QueryRow(ctx, `SELECT status FROM booking_service.waitlist WHERE id = $1 FOR UPDATE`, entryId).Scan(&status); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Booking{}, utills.ErrNoRows
+		}
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	if status != utills.WaitlistWaiting {
+		return models.Booking{}, utills.ErrNoRows
+	}
+
+	if err := s.checkQuota(ctx, tx, bookingType, booking, check); err != nil {
+		return models.Booking{}, err
+	}
+	created, err := s.createBookingTx(ctx, tx, table, resourceColumn, booking)
+	if err != nil {
+		return models.Booking{}, err
+	}
+	if _, err := tx.Exec(ctx, `UPDATE booking_service.waitlist SET status = $2, booking_id = $3, updated_at = now() WHERE id = $1`,
+		entryId, utills.WaitlistPromoted, created.BookingId); err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	event.BookingId = created.BookingId
+	if err := s.addBookingEvent(ctx, tx, event); err != nil {
+		return models.Booking{}, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		s.logger.Warn(err)
+		return models.Booking{}, err
+	}
+	return created, nil
+}
@@ -1,5 +1,83 @@
 package utills
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"time"
+)
 
 var ErrNoRows = errors.New("no rows in result set")
+
+var ErrResourceUnavailable = errors.New("resource is out of service")
+
+var ErrOutsideCheckInWindow = errors.New("no booking to check in within the check-in window")
+
+var ErrInvalidTimeRange = errors.New("booking must end after it starts")
+
+var ErrBookingNotActive = errors.New("booking is not active")
+
+var ErrResourceClosed = errors.New("resource is closed at this time")
+
+var ErrRoomCapacityExceeded = errors.New("attendees do not fit into the room")
+
+var ErrNotBookingOwner = errors.New("booking belongs to another user")
+
+var ErrNotDelegate = errors.New("user is not allowed to book for another user")
+
+var ErrNotPrincipal = errors.New("only the principal can delegate their bookings")
+
+var ErrTransferToOwner = errors.New("booking can not be transferred to its owner")
+
+var ErrInvalidRRule = errors.New("invalid recurrence rule")
+
+var ErrInvalidTransition = errors.New("invalid booking status transition")
+
+var ErrBookingConflict = errors.New("booking conflicts with an existing booking")
+
+var ErrPoolFull = fmt.Errorf("%w: no free units left in the pool", ErrBookingConflict)
+
+var ErrPolicyViolation = errors.New("booking violates office policy")
+
+// PolicyViolationError names the violated rule, Reason is a machine-readable code for clients.
+type PolicyViolationError struct {
+	Reason string
+	Limit  string
+}
+
+func (e *PolicyViolationError) Error() string {
+	return fmt.Sprintf("%s: %s (limit %s)", ErrPolicyViolation.Error(), e.Reason, e.Limit)
+}
+
+func (e *PolicyViolationError) Unwrap() error {
+	return ErrPolicyViolation
+}
+
+type BookingConflictError struct {
+	BookingId int64
+}
+
+func (e *BookingConflictError) Error() string {
+	return fmt.Sprintf("%s: %d", ErrBookingConflict.Error(), e.BookingId)
+}
+
+func (e *BookingConflictError) Unwrap() error {
+	return ErrBookingConflict
+}
+
+// ExtensionConflictError is returned when a booking cannot be extended as far as asked.
+// The booking can still be extended up to MaxEndTime, or the user can move to one of the
+// alternative resources, which are free for the requested extension.
+type ExtensionConflictError struct {
+	BookingId              int64
+	MaxEndTime             time.Time
+	MaxExtension           time.Duration
+	AlternativeResourceIds []int64
+}
+
+func (e *ExtensionConflictError) Error() string {
+	return fmt.Sprintf("%s: %d, booking can be extended until %s", ErrBookingConflict.Error(), e.BookingId, e.MaxEndTime.Format(time.RFC3339))
+}
+
+func (e *ExtensionConflictError) Unwrap() error {
+	return ErrBookingConflict
+}
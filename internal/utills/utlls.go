@@ -4,12 +4,54 @@ const (
 	StatusPending   = "PENDING"
 	StatusConfirmed = "CONFIRMED"
 	StatusDone      = "DONE" // для click'a
+	StatusCancelled = "CANCELLED"
+	StatusNoShow    = "NO_SHOW"
+	StatusExpired   = "EXPIRED"
+)
+
+// ClickStatusCanceled is how a cancellation is written to booking_analytics
+const ClickStatusCanceled = "CANCELED"
+
+// Moves to another resource and transfers to another user, in booking_analytics and booking_change_history
+const (
+	ClickStatusMoved       = "MOVED"
+	ClickStatusTransferred = "TRANSFERRED"
 )
 
 const PageSize = 15
 const (
 	WorkplaceType = "workplace"
 	ParkingType   = "parking"
+	RoomType      = "room" // переговорные, ресурс - workplace
+	PoolType      = "pool" // любое из N мест зоны, ресурс - resource_pools
+)
+
+const (
+	ScopeThisOccurrence   = "this"
+	ScopeThisAndFollowing = "following"
+	ScopeWholeSeries      = "series"
+	ScopeWholeBundle      = "bundle"
+)
+
+const (
+	WaitlistWaiting  = "WAITING"
+	WaitlistPromoted = "PROMOTED"
+	WaitlistLeft     = "LEFT"
+)
+
+const (
+	AttendeeInvited  = "INVITED"
+	AttendeeAccepted = "ACCEPTED"
+	AttendeeDeclined = "DECLINED"
+)
+
+const (
+	TransferPending   = "PENDING"
+	TransferAccepted  = "ACCEPTED"
+	TransferDeclined  = "DECLINED"
+	TransferCancelled = "CANCELLED" // владелец предложил бронь кому-то ещё
 )
 
-const TimeLayout = "2006-01-02"
+const EventWaitlistPromoted = "WAITLIST_PROMOTED"
+const EventTransferOffered = "TRANSFER_OFFERED"
+const EventGuestInvited = "GUEST_INVITED"
@@ -22,3 +22,19 @@ CREATE TABLE analytics.booking_analytics(
 ENGINE = MergeTree
 ORDER BY (booking_id, user_id, event_date)
 PRIMARY KEY (booking_id, user_id)
+;
+
+ALTER TABLE analytics.booking_analytics
+    MODIFY COLUMN booking_status Enum('PENDING' = 1, 'CONFIRMED' = 2, 'DONE' = 3, 'CANCELED' = 4, 'NO_SHOW' = 5);
+
+ALTER TABLE analytics.booking_analytics
+    MODIFY COLUMN booking_type Enum('workplace' = 1, 'parking' = 2, 'room' = 3);
+
+ALTER TABLE analytics.booking_analytics
+    MODIFY COLUMN booking_type Enum('workplace' = 1, 'parking' = 2, 'room' = 3, 'pool' = 4);
+
+ALTER TABLE analytics.booking_analytics
+    MODIFY COLUMN booking_status Enum('PENDING' = 1, 'CONFIRMED' = 2, 'DONE' = 3, 'CANCELED' = 4, 'NO_SHOW' = 5, 'MOVED' = 6, 'TRANSFERRED' = 7);
+
+ALTER TABLE analytics.booking_analytics
+    MODIFY COLUMN booking_status Enum('PENDING' = 1, 'CONFIRMED' = 2, 'DONE' = 3, 'CANCELED' = 4, 'NO_SHOW' = 5, 'MOVED' = 6, 'TRANSFERRED' = 7, 'EXPIRED' = 8);
@@ -25,3 +25,365 @@ ALTER TABLE booking_service."booking" ADD CONSTRAINT check_booking_dates
     CHECK (end_date > start_date);
 ALTER TABLE booking_service."booking" ADD FOREIGN KEY ("workplace_id") REFERENCES resource_service.workplace ("id");
 
+
+ALTER TABLE booking_service."parking_bookings" ADD CONSTRAINT check_parking_booking_dates
+    CHECK (end_date > start_date);
+
+CREATE EXTENSION IF NOT EXISTS btree_gist;
+
+ALTER TABLE booking_service."booking"
+    ALTER COLUMN "start_date" TYPE timestamptz USING "start_date" AT TIME ZONE 'UTC',
+    ALTER COLUMN "end_date" TYPE timestamptz USING "end_date" AT TIME ZONE 'UTC';
+ALTER TABLE booking_service."parking_bookings"
+    ALTER COLUMN "start_date" TYPE timestamptz USING "start_date" AT TIME ZONE 'UTC',
+    ALTER COLUMN "end_date" TYPE timestamptz USING "end_date" AT TIME ZONE 'UTC';
+
+ALTER TABLE booking_service."booking" ADD CONSTRAINT booking_no_overlap
+    EXCLUDE USING gist ("workplace_id" WITH =, tstzrange("start_date", "end_date") WITH &&);
+ALTER TABLE booking_service."parking_bookings" ADD CONSTRAINT parking_bookings_no_overlap
+    EXCLUDE USING gist ("parking_space_id" WITH =, tstzrange("start_date", "end_date") WITH &&);
+
+CREATE TABLE booking_service."booking_series" (
+                                                  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+                                                  "user_id" varchar not null,
+                                                  "booking_type" varchar not null,
+                                                  "resource_id" int not null,
+                                                  "rrule" varchar not null,
+                                                  "created_at" timestamptz not null default now()
+);
+
+ALTER TABLE booking_service."booking" ADD COLUMN "series_id" int REFERENCES booking_service."booking_series" ("id");
+ALTER TABLE booking_service."parking_bookings" ADD COLUMN "series_id" int REFERENCES booking_service."booking_series" ("id");
+CREATE INDEX booking_series_id_idx ON booking_service."booking" ("series_id");
+CREATE INDEX parking_bookings_series_id_idx ON booking_service."parking_bookings" ("series_id");
+
+CREATE TABLE booking_service."waitlist" (
+                                            "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+                                            "user_id" varchar not null,
+                                            "booking_type" varchar not null,
+                                            "resource_id" int,
+                                            "zone" varchar not null default '',
+                                            "floor" int not null default 0,
+                                            "start_date" timestamptz not null,
+                                            "end_date" timestamptz not null,
+                                            "priority" int not null default 0,
+                                            "status" varchar not null,
+                                            "booking_id" int,
+                                            "created_at" timestamptz not null default now(),
+                                            "updated_at" timestamptz not null default now(),
+                                            CHECK (end_date > start_date),
+                                            CHECK (resource_id IS NOT NULL OR zone <> '')
+);
+CREATE INDEX waitlist_waiting_idx ON booking_service."waitlist" ("booking_type", "status", "start_date");
+
+CREATE TABLE booking_service."booking_events" (
+                                                  "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+                                                  "event_type" varchar not null,
+                                                  "user_id" varchar not null,
+                                                  "booking_type" varchar not null,
+                                                  "booking_id" int not null,
+                                                  "payload" jsonb not null default '{}',
+                                                  "created_at" timestamptz not null default now(),
+                                                  "sent_at" timestamptz
+);
+CREATE INDEX booking_events_unsent_idx ON booking_service."booking_events" ("created_at") WHERE "sent_at" IS NULL;
+
+ALTER TABLE booking_service."booking" DROP CONSTRAINT booking_no_overlap;
+ALTER TABLE booking_service."booking" ADD CONSTRAINT booking_no_overlap
+    EXCLUDE USING gist ("workplace_id" WITH =, tstzrange("start_date", "end_date") WITH &&)
+    WHERE ("status" <> 'NO_SHOW');
+ALTER TABLE booking_service."parking_bookings" DROP CONSTRAINT parking_bookings_no_overlap;
+ALTER TABLE booking_service."parking_bookings" ADD CONSTRAINT parking_bookings_no_overlap
+    EXCLUDE USING gist ("parking_space_id" WITH =, tstzrange("start_date", "end_date") WITH &&)
+    WHERE ("status" <> 'NO_SHOW');
+
+CREATE TABLE booking_service."booking_status_history" (
+                                                          "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+                                                          "booking_id" int not null,
+                                                          "booking_type" varchar not null,
+                                                          "from_status" varchar not null,
+                                                          "to_status" varchar not null,
+                                                          "changed_at" timestamptz not null default now()
+);
+CREATE INDEX booking_status_history_booking_idx ON booking_service."booking_status_history" ("booking_type", "booking_id");
+
+-- Номер машины заполняется только для parking, в booking колонка нужна для общего набора колонок
+ALTER TABLE booking_service."parking_bookings" ADD COLUMN "licence_plate" varchar;
+ALTER TABLE booking_service."booking" ADD COLUMN "licence_plate" varchar;
+CREATE INDEX parking_bookings_licence_plate_idx ON booking_service."parking_bookings" ("licence_plate");
+
+ALTER TABLE booking_service."booking"
+    ADD COLUMN "cancelled_at" timestamptz,
+    ADD COLUMN "cancelled_by" varchar,
+    ADD COLUMN "cancel_reason" varchar;
+ALTER TABLE booking_service."parking_bookings"
+    ADD COLUMN "cancelled_at" timestamptz,
+    ADD COLUMN "cancelled_by" varchar,
+    ADD COLUMN "cancel_reason" varchar;
+
+ALTER TABLE booking_service."booking" DROP CONSTRAINT booking_no_overlap;
+ALTER TABLE booking_service."booking" ADD CONSTRAINT booking_no_overlap
+    EXCLUDE USING gist ("workplace_id" WITH =, tstzrange("start_date", "end_date") WITH &&)
+    WHERE ("status" NOT IN ('NO_SHOW', 'CANCELLED', 'EXPIRED'));
+ALTER TABLE booking_service."parking_bookings" DROP CONSTRAINT parking_bookings_no_overlap;
+ALTER TABLE booking_service."parking_bookings" ADD CONSTRAINT parking_bookings_no_overlap
+    EXCLUDE USING gist ("parking_space_id" WITH =, tstzrange("start_date", "end_date") WITH &&)
+    WHERE ("status" NOT IN ('NO_SHOW', 'CANCELLED', 'EXPIRED'));
+
+CREATE TABLE booking_service."booking_bundles" (
+                                                   "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+                                                   "user_id" varchar not null,
+                                                   "created_at" timestamptz not null default now()
+);
+ALTER TABLE booking_service."booking" ADD COLUMN "bundle_id" int REFERENCES booking_service."booking_bundles" ("id");
+ALTER TABLE booking_service."parking_bookings" ADD COLUMN "bundle_id" int REFERENCES booking_service."booking_bundles" ("id");
+CREATE INDEX booking_bundle_idx ON booking_service."booking" ("bundle_id");
+CREATE INDEX parking_bookings_bundle_idx ON booking_service."parking_bookings" ("bundle_id");
+
+-- Переговорные: те же колонки, что у booking, плюс приглашённые
+CREATE TABLE booking_service."room_bookings" (
+                                                 "id" int GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+                                                 "user_id" varchar NOT NULL,
+                                                 "workplace_id" int NOT NULL,
+                                                 "start_date" timestamptz NOT NULL,
+                                                 "end_date" timestamptz NOT NULL,
+                                                 "status" varchar NOT NULL,
+                                                 "series_id" int REFERENCES booking_service."booking_series" ("id"),
+                                                 "bundle_id" int REFERENCES booking_service."booking_bundles" ("id"),
+                                                 "licence_plate" varchar,
+                                                 "cancelled_at" timestamptz,
+                                                 "cancelled_by" varchar,
+                                                 "cancel_reason" varchar,
+                                                 "created_at" timestamptz NOT NULL default now(),
+                                                 "updated_at" timestamptz NOT NULL default now(),
+                                                 FOREIGN KEY ("workplace_id") REFERENCES resource_service.workplace ("id"),
+                                                 CHECK (end_date > start_date)
+);
+-- Переговорная - тоже workplace: пересечения с бронями booking того же workplace_id
+-- проверяет сервис под общим advisory lock, exclusion constraint между таблицами не работает
+ALTER TABLE booking_service."room_bookings" ADD CONSTRAINT room_bookings_no_overlap
+    EXCLUDE USING gist ("workplace_id" WITH =, tstzrange("start_date", "end_date") WITH &&)
+    WHERE ("status" NOT IN ('NO_SHOW', 'CANCELLED', 'EXPIRED'));
+CREATE INDEX room_bookings_series_idx ON booking_service."room_bookings" ("series_id");
+CREATE INDEX room_bookings_bundle_idx ON booking_service."room_bookings" ("bundle_id");
+
+CREATE TABLE booking_service."room_attendees" (
+                                                  "booking_id" int NOT NULL REFERENCES booking_service."room_bookings" ("id") ON DELETE CASCADE,
+                                                  "user_id" varchar NOT NULL,
+                                                  "status" varchar NOT NULL,
+                                                  "responded_at" timestamptz,
+                                                  PRIMARY KEY ("booking_id", "user_id")
+);
+CREATE INDEX room_attendees_user_idx ON booking_service."room_attendees" ("user_id");
+
+-- Пулы: "любое из N мест" зоны. Бронь занимает одно место, пересечения ограничены capacity,
+-- поэтому exclusion constraint нет - вместимость проверяет сервис под advisory lock пула
+CREATE TABLE booking_service."resource_pools" (
+                                                  "id" int GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+                                                  "name" varchar NOT NULL,
+                                                  "zone" varchar NOT NULL,
+                                                  "floor" int NOT NULL default 0,
+                                                  "resource_type" varchar NOT NULL, -- workplace или parking
+                                                  "capacity" int NOT NULL CHECK (capacity > 0),
+                                                  "is_available" boolean NOT NULL default true,
+                                                  "created_at" timestamptz NOT NULL default now()
+);
+
+CREATE TABLE booking_service."pool_bookings" (
+                                                 "id" int GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+                                                 "user_id" varchar NOT NULL,
+                                                 "pool_id" int NOT NULL REFERENCES booking_service."resource_pools" ("id"),
+                                                 "start_date" timestamptz NOT NULL,
+                                                 "end_date" timestamptz NOT NULL,
+                                                 "status" varchar NOT NULL,
+                                                 "series_id" int REFERENCES booking_service."booking_series" ("id"),
+                                                 "bundle_id" int REFERENCES booking_service."booking_bundles" ("id"),
+                                                 "licence_plate" varchar,
+                                                 "cancelled_at" timestamptz,
+                                                 "cancelled_by" varchar,
+                                                 "cancel_reason" varchar,
+                                                 "created_at" timestamptz NOT NULL default now(),
+                                                 "updated_at" timestamptz NOT NULL default now(),
+                                                 CHECK (end_date > start_date)
+);
+CREATE INDEX pool_bookings_pool_range_idx ON booking_service."pool_bookings" USING gist ("pool_id", tstzrange("start_date", "end_date"));
+CREATE INDEX pool_bookings_series_idx ON booking_service."pool_bookings" ("series_id");
+CREATE INDEX pool_bookings_bundle_idx ON booking_service."pool_bookings" ("bundle_id");
+
+-- Политики бронирования по типу брони, перекрывают booking.policies из конфига. 0 - без ограничения
+CREATE TABLE booking_service."policies" (
+                                            "booking_type" varchar PRIMARY KEY,
+                                            "max_duration_minutes" int NOT NULL default 0,
+                                            "max_advance_days" int NOT NULL default 0,
+                                            "min_lead_minutes" int NOT NULL default 0,
+                                            "max_active_bookings" int NOT NULL default 0,
+                                            "max_days_per_week" int NOT NULL default 0,
+                                            "updated_at" timestamptz NOT NULL default now()
+);
+
+-- Зоны, доступные только перечисленным группам. Зоны без строк открыты всем
+CREATE TABLE booking_service."policy_zone_groups" (
+                                                      "booking_type" varchar NOT NULL REFERENCES booking_service."policies" ("booking_type") ON DELETE CASCADE,
+                                                      "zone" varchar NOT NULL,
+                                                      "group_name" varchar NOT NULL,
+                                                      PRIMARY KEY ("booking_type", "zone", "group_name")
+);
+
+CREATE TABLE booking_service."user_groups" (
+                                               "user_id" varchar NOT NULL,
+                                               "group_name" varchar NOT NULL,
+                                               PRIMARY KEY ("user_id", "group_name")
+);
+
+-- Календари зон: часы работы по дням недели (минуты от полуночи, weekday 0 - воскресенье).
+-- Если у зоны нет строк, действуют opening_hour/closing_hour из конфига, иначе дни без строки - выходные.
+-- Задаются через SetOpeningHours
+CREATE TABLE booking_service."opening_hours" (
+                                                 "zone" varchar NOT NULL,
+                                                 "weekday" int NOT NULL CHECK (weekday BETWEEN 0 AND 6),
+                                                 "opens_minute" int NOT NULL CHECK (opens_minute BETWEEN 0 AND 1440),
+                                                 "closes_minute" int NOT NULL CHECK (closes_minute BETWEEN 0 AND 1440),
+                                                 PRIMARY KEY ("zone", "weekday"),
+                                                 CHECK (closes_minute > opens_minute)
+);
+
+-- Праздники, zone NULL - для всех зон. Заполняются cmd/import-holidays из .ics
+CREATE TABLE booking_service."holidays" (
+                                            "id" int GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+                                            "zone" varchar,
+                                            "date" date NOT NULL,
+                                            "name" varchar NOT NULL default ''
+);
+CREATE UNIQUE INDEX holidays_zone_date_idx ON booking_service."holidays" (COALESCE("zone", ''), "date");
+
+CREATE TABLE booking_service."blackouts" (
+                                             "id" int GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+                                             "booking_type" varchar NOT NULL,
+                                             "resource_id" int NOT NULL,
+                                             "start_date" timestamptz NOT NULL,
+                                             "end_date" timestamptz NOT NULL,
+                                             "reason" varchar NOT NULL default '',
+                                             "created_at" timestamptz NOT NULL default now(),
+                                             CHECK (end_date > start_date)
+);
+CREATE INDEX blackouts_resource_idx ON booking_service."blackouts" ("booking_type", "resource_id", "start_date");
+
+-- Часовые пояса офисов (IANA, например Europe/Moscow). Для зон без строки - booking.time_zone из конфига
+CREATE TABLE booking_service."zone_time_zones" (
+                                                   "zone" varchar PRIMARY KEY,
+                                                   "time_zone" varchar NOT NULL
+);
+
+-- Оставшиеся timestamp-колонки писались сервисом в UTC
+ALTER TABLE booking_service."booking"
+    ALTER COLUMN "created_at" TYPE timestamptz USING "created_at" AT TIME ZONE 'UTC',
+    ALTER COLUMN "updated_at" TYPE timestamptz USING "updated_at" AT TIME ZONE 'UTC';
+ALTER TABLE booking_service."parking_bookings"
+    ALTER COLUMN "created_at" TYPE timestamptz USING "created_at" AT TIME ZONE 'UTC',
+    ALTER COLUMN "updated_at" TYPE timestamptz USING "updated_at" AT TIME ZONE 'UTC';
+
+ALTER TABLE booking_service."booking" ADD COLUMN "time_zone" varchar;
+ALTER TABLE booking_service."parking_bookings" ADD COLUMN "time_zone" varchar;
+ALTER TABLE booking_service."room_bookings" ADD COLUMN "time_zone" varchar;
+ALTER TABLE booking_service."pool_bookings" ADD COLUMN "time_zone" varchar;
+
+-- Буферы (подготовка/уборка): ресурс занят на [blocked_start, blocked_end), видимые времена брони не меняются.
+-- Пересечения запрещены по занятому интервалу, поэтому буферы соседних броней складываются
+ALTER TABLE booking_service."booking" ADD COLUMN "blocked_start" timestamptz, ADD COLUMN "blocked_end" timestamptz;
+ALTER TABLE booking_service."parking_bookings" ADD COLUMN "blocked_start" timestamptz, ADD COLUMN "blocked_end" timestamptz;
+ALTER TABLE booking_service."room_bookings" ADD COLUMN "blocked_start" timestamptz, ADD COLUMN "blocked_end" timestamptz;
+ALTER TABLE booking_service."pool_bookings" ADD COLUMN "blocked_start" timestamptz, ADD COLUMN "blocked_end" timestamptz;
+UPDATE booking_service."booking" SET blocked_start = start_date, blocked_end = end_date;
+UPDATE booking_service."parking_bookings" SET blocked_start = start_date, blocked_end = end_date;
+UPDATE booking_service."room_bookings" SET blocked_start = start_date, blocked_end = end_date;
+UPDATE booking_service."pool_bookings" SET blocked_start = start_date, blocked_end = end_date;
+ALTER TABLE booking_service."booking" ALTER COLUMN "blocked_start" SET NOT NULL, ALTER COLUMN "blocked_end" SET NOT NULL;
+ALTER TABLE booking_service."parking_bookings" ALTER COLUMN "blocked_start" SET NOT NULL, ALTER COLUMN "blocked_end" SET NOT NULL;
+ALTER TABLE booking_service."room_bookings" ALTER COLUMN "blocked_start" SET NOT NULL, ALTER COLUMN "blocked_end" SET NOT NULL;
+ALTER TABLE booking_service."pool_bookings" ALTER COLUMN "blocked_start" SET NOT NULL, ALTER COLUMN "blocked_end" SET NOT NULL;
+
+ALTER TABLE booking_service."booking" DROP CONSTRAINT booking_no_overlap;
+ALTER TABLE booking_service."booking" ADD CONSTRAINT booking_no_overlap
+    EXCLUDE USING gist ("workplace_id" WITH =, tstzrange("blocked_start", "blocked_end") WITH &&)
+    WHERE ("status" NOT IN ('NO_SHOW', 'CANCELLED', 'EXPIRED'));
+ALTER TABLE booking_service."parking_bookings" DROP CONSTRAINT parking_bookings_no_overlap;
+ALTER TABLE booking_service."parking_bookings" ADD CONSTRAINT parking_bookings_no_overlap
+    EXCLUDE USING gist ("parking_space_id" WITH =, tstzrange("blocked_start", "blocked_end") WITH &&)
+    WHERE ("status" NOT IN ('NO_SHOW', 'CANCELLED', 'EXPIRED'));
+ALTER TABLE booking_service."room_bookings" DROP CONSTRAINT room_bookings_no_overlap;
+ALTER TABLE booking_service."room_bookings" ADD CONSTRAINT room_bookings_no_overlap
+    EXCLUDE USING gist ("workplace_id" WITH =, tstzrange("blocked_start", "blocked_end") WITH &&)
+    WHERE ("status" NOT IN ('NO_SHOW', 'CANCELLED', 'EXPIRED'));
+DROP INDEX booking_service.pool_bookings_pool_range_idx;
+CREATE INDEX pool_bookings_pool_range_idx ON booking_service."pool_bookings" USING gist ("pool_id", tstzrange("blocked_start", "blocked_end"));
+
+-- Буферы отдельных ресурсов, перекрывают booking.buffers из конфига
+CREATE TABLE booking_service."resource_buffers" (
+                                                    "booking_type" varchar NOT NULL,
+                                                    "resource_id" int NOT NULL,
+                                                    "before_minutes" int NOT NULL default 0 CHECK (before_minutes >= 0),
+                                                    "after_minutes" int NOT NULL default 0 CHECK (after_minutes >= 0),
+                                                    PRIMARY KEY ("booking_type", "resource_id")
+);
+
+-- Переносы броней на другой ресурс и передачи другому пользователю, id брони при этом не меняется
+CREATE TABLE booking_service."booking_change_history" (
+                                                          "id" INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+                                                          "booking_id" int NOT NULL,
+                                                          "booking_type" varchar NOT NULL,
+                                                          "change" varchar NOT NULL, -- MOVED или TRANSFERRED
+                                                          "from_value" varchar NOT NULL,
+                                                          "to_value" varchar NOT NULL,
+                                                          "changed_by" varchar NOT NULL,
+                                                          "changed_at" timestamptz NOT NULL default now()
+);
+CREATE INDEX booking_change_history_booking_idx ON booking_service."booking_change_history" ("booking_type", "booking_id");
+
+-- Предложения передать бронь, которые получатель должен принять
+CREATE TABLE booking_service."booking_transfers" (
+                                                     "id" int GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+                                                     "booking_type" varchar NOT NULL,
+                                                     "booking_id" int NOT NULL,
+                                                     "from_user_id" varchar NOT NULL,
+                                                     "to_user_id" varchar NOT NULL,
+                                                     "status" varchar NOT NULL, -- PENDING, ACCEPTED, DECLINED, CANCELLED
+                                                     "created_at" timestamptz NOT NULL default now(),
+                                                     "responded_at" timestamptz
+);
+CREATE UNIQUE INDEX booking_transfers_pending_idx ON booking_service."booking_transfers" ("booking_type", "booking_id") WHERE "status" = 'PENDING';
+CREATE INDEX booking_transfers_to_user_idx ON booking_service."booking_transfers" ("to_user_id", "status");
+
+-- Бронирование за других: booked_by - кто сделал бронь, NULL - сам владелец (user_id)
+ALTER TABLE booking_service."booking" ADD COLUMN "booked_by" varchar;
+ALTER TABLE booking_service."parking_bookings" ADD COLUMN "booked_by" varchar;
+ALTER TABLE booking_service."room_bookings" ADD COLUMN "booked_by" varchar;
+ALTER TABLE booking_service."pool_bookings" ADD COLUMN "booked_by" varchar;
+CREATE INDEX booking_booked_by_idx ON booking_service."booking" ("booked_by") WHERE "booked_by" IS NOT NULL;
+CREATE INDEX parking_bookings_booked_by_idx ON booking_service."parking_bookings" ("booked_by") WHERE "booked_by" IS NOT NULL;
+CREATE INDEX room_bookings_booked_by_idx ON booking_service."room_bookings" ("booked_by") WHERE "booked_by" IS NOT NULL;
+CREATE INDEX pool_bookings_booked_by_idx ON booking_service."pool_bookings" ("booked_by") WHERE "booked_by" IS NOT NULL;
+
+-- delegate_id может бронировать и отменять брони за principal_id. booking_type NULL - любые типы
+CREATE TABLE booking_service."delegations" (
+                                               "id" int GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+                                               "delegate_id" varchar NOT NULL,
+                                               "principal_id" varchar NOT NULL,
+                                               "booking_type" varchar,
+                                               "created_at" timestamptz NOT NULL default now(),
+                                               CHECK ("delegate_id" <> "principal_id")
+);
+CREATE UNIQUE INDEX delegations_unique_idx ON booking_service."delegations" ("delegate_id", "principal_id", COALESCE("booking_type", ''));
+CREATE INDEX delegations_principal_idx ON booking_service."delegations" ("principal_id");
+
+-- Гости: бронь оформлена на принимающего сотрудника (user_id), гость отмечается одноразовым кодом.
+-- Код действует до конца визита, непришедший гость в конце визита получает EXPIRED
+CREATE TABLE booking_service."booking_guests" (
+                                                  "booking_type" varchar NOT NULL,
+                                                  "booking_id" int NOT NULL,
+                                                  "guest_name" varchar NOT NULL,
+                                                  "guest_email" varchar,
+                                                  "guest_company" varchar,
+                                                  "check_in_code" varchar NOT NULL UNIQUE,
+                                                  "checked_in_at" timestamptz,
+                                                  PRIMARY KEY ("booking_type", "booking_id")
+);